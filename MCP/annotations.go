@@ -0,0 +1,117 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/jira-7-6-1/mcp-server/operations"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// hints are the behaviour annotations advertised for a tool.
+type hints struct {
+	readOnly    bool
+	destructive bool
+	idempotent  bool
+	title       string // Optional title replacing the one derived from the name
+}
+
+// methodHints are the defaults for each HTTP method.
+var methodHints = map[string]hints{
+	"GET":    {readOnly: true, idempotent: true},
+	"HEAD":   {readOnly: true, idempotent: true},
+	"PUT":    {idempotent: true},
+	"POST":   {},
+	"DELETE": {destructive: true, idempotent: true},
+}
+
+// hintOverrides correct the method defaults for endpoints whose method does
+// not describe their effect, keyed by generated tool name.
+var hintOverrides = map[string]hints{
+	// Queries sent as POST because their input is too large for a URL.
+	"post_api_2_search":                     {readOnly: true, idempotent: true, title: "Search issues (POST)"},
+	"post_api_2_worklog_list":               {readOnly: true, idempotent: true},
+	"post_api_2_licenseValidator":           {readOnly: true, idempotent: true},
+	"post_api_2_password_policy_createUser": {readOnly: true, idempotent: true},
+	"post_api_2_password_policy_updateUser": {readOnly: true, idempotent: true},
+
+	// GETs that change server state.
+	"get_api_2_monitoring_jmx_startExposing": {idempotent: true},
+	"get_api_2_monitoring_jmx_stopExposing":  {idempotent: true},
+
+	// Heavy or instance-wide administrative operations.
+	"post_api_2_reindex":                  {destructive: true, title: "Start reindex (heavy, admin only)"},
+	"post_api_2_reindex_request":          {destructive: true, title: "Process reindex requests (admin only)"},
+	"post_api_2_upgrade":                  {destructive: true, title: "Run upgrades now (admin only)"},
+	"post_api_2_cluster_zdu_start":        {destructive: true, title: "Start ZDU upgrade (admin only)"},
+	"post_api_2_cluster_zdu_approve":      {destructive: true, title: "Approve ZDU upgrade (admin only)"},
+	"post_api_2_cluster_zdu_cancel":       {destructive: true, title: "Cancel ZDU upgrade (admin only)"},
+	"post_api_2_cluster_zdu_retryUpgrade": {destructive: true, title: "Retry ZDU upgrade (admin only)"},
+	"put_api_2_settings_baseUrl":          {destructive: true, idempotent: true, title: "Set base URL (admin only)"},
+
+	// Updates that delete or overwrite data beyond the target resource.
+	"post_api_2_version_id_removeAndSwap":              {destructive: true},
+	"put_api_2_version_id_mergeto_moveIssuesTo":        {destructive: true},
+	"put_api_2_myself_password":                        {destructive: true, idempotent: true},
+	"put_api_2_user_password":                          {destructive: true, idempotent: true},
+	"post_api_2_issue_issueIdOrKey_subtask_move":       {idempotent: true},
+	"post_api_2_version_versionId_remotelink_globalId": {idempotent: true},
+}
+
+// annotate sets the title and read-only, destructive, idempotent and
+// open-world hints of every tool so clients can decide which calls need
+// approval. Every tool talks to a Jira server, so all are open-world.
+func annotate(tools []models.Tool) []models.Tool {
+	for i := range tools {
+		h := toolHints(tools[i].Definition.Name)
+		title := h.title
+		if title == "" {
+			title = toolTitle(tools[i].Definition.Name)
+		}
+		tools[i].Definition.Annotations = mcp.ToolAnnotation{
+			Title:           title,
+			ReadOnlyHint:    mcp.ToBoolPtr(h.readOnly),
+			DestructiveHint: mcp.ToBoolPtr(h.destructive),
+			IdempotentHint:  mcp.ToBoolPtr(h.idempotent),
+			OpenWorldHint:   mcp.ToBoolPtr(true),
+		}
+	}
+	return tools
+}
+
+func toolHints(name string) hints {
+	legacy := name
+	if op, ok := operations.Resolve(name); ok {
+		legacy = op.Legacy
+	}
+	if h, ok := hintOverrides[legacy]; ok {
+		return h
+	}
+	return methodHints[toolMethod(name)]
+}
+
+// titleWords are spelled differently in titles than in tool names.
+var titleWords = map[string]string{
+	"id":  "ID",
+	"ids": "IDs",
+	"jql": "JQL",
+	"jmx": "JMX",
+	"url": "URL",
+	"zdu": "ZDU",
+}
+
+// toolTitle derives a human-readable title from a tool name, e.g.
+// jira_get_issue becomes "Get issue".
+func toolTitle(name string) string {
+	words := strings.Split(strings.TrimPrefix(name, "jira_"), "_")
+	for i, w := range words {
+		if t, ok := titleWords[w]; ok {
+			words[i] = t
+		}
+	}
+	title := strings.Join(words, " ")
+	if title == "" {
+		return name
+	}
+	return strings.ToUpper(title[:1]) + title[1:]
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/jira-7-6-1/mcp-server/logging"
+)
+
+// HTTPClient is the client used for every Jira call made by the tools.
+var HTTPClient = http.DefaultClient
+
+// ErrReadOnly is returned by Do when a write request is attempted while the
+// server runs in read-only mode.
+var ErrReadOnly = errors.New("server is in read-only mode")
+
+type contextKey int
+
+const readOnlyKey contextKey = iota
+
+// WithReadOnly marks ctx so that Do refuses any request that could modify Jira.
+func WithReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey, true)
+}
+
+// IsReadOnly reports whether ctx was marked with WithReadOnly.
+func IsReadOnly(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(readOnlyKey).(bool)
+	return readOnly
+}
+
+// IsSafeMethod reports whether an HTTP method is free of side effects.
+func IsSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// Do sends req to Jira and logs the endpoint, status and duration together
+// with the request ID and tool name carried by the request context. In a
+// dry run write requests are recorded instead of sent.
+func Do(req *http.Request) (*http.Response, error) {
+	logger := logging.FromContext(req.Context())
+	endpoint := req.Method + " " + req.URL.Path
+	if IsReadOnly(req.Context()) && !IsSafeMethod(req.Method) {
+		logger.Warn("jira request blocked", "endpoint", endpoint, "reason", "read-only mode")
+		return nil, fmt.Errorf("%s blocked: %w", endpoint, ErrReadOnly)
+	}
+	if rec := dryRunRecorder(req.Context()); rec != nil && !IsSafeMethod(req.Method) {
+		logger.Info("jira request recorded", "endpoint", endpoint, "reason", "dry run")
+		return recordRequest(rec, req)
+	}
+	start := time.Now()
+
+	resp, err := HTTPClient.Do(req)
+	duration := time.Since(start)
+	if err != nil {
+		logger.Error("jira request failed", "endpoint", endpoint, "duration_ms", duration.Milliseconds(), "error", err)
+		return nil, err
+	}
+
+	level := slog.LevelInfo
+	if resp.StatusCode >= 400 {
+		level = slog.LevelWarn
+	}
+	logger.Log(req.Context(), level, "jira request", "endpoint", endpoint, "status", resp.StatusCode, "duration_ms", duration.Milliseconds())
+	return resp, nil
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDoReadOnly(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer srv.Close()
+
+	ctx := WithReadOnly(context.Background())
+	tests := []struct {
+		method  string
+		blocked bool
+	}{
+		{http.MethodGet, false},
+		{http.MethodHead, false},
+		{http.MethodPost, true},
+		{http.MethodPut, true},
+		{http.MethodDelete, true},
+	}
+	for _, tt := range tests {
+		calls = 0
+		req, err := http.NewRequestWithContext(ctx, tt.method, srv.URL+"/rest/api/2/issue/ABC-1", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		if blocked := errors.Is(err, ErrReadOnly); blocked != tt.blocked {
+			t.Errorf("Do(%s) blocked = %v, want %v (err %v)", tt.method, blocked, tt.blocked, err)
+		}
+		if sent := calls > 0; sent == tt.blocked {
+			t.Errorf("Do(%s) sent the request = %v, want %v", tt.method, sent, !tt.blocked)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/jira-7-6-1/mcp-server/logging"
+)
+
+// RecordedRequest is a write request that Do recorded instead of sending.
+// Credentials in headers and body are redacted.
+type RecordedRequest struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+	Body    any               `json:"body,omitempty"`
+
+	raw []byte
+}
+
+// RawBody returns the body as it would have been sent, without redaction,
+// so that it can be validated.
+func (r RecordedRequest) RawBody() []byte {
+	return r.raw
+}
+
+// Recorder collects the write requests of a dry run.
+type Recorder struct {
+	mu       sync.Mutex
+	requests []RecordedRequest
+}
+
+// Requests returns the requests recorded so far.
+func (r *Recorder) Requests() []RecordedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]RecordedRequest(nil), r.requests...)
+}
+
+func (r *Recorder) record(req RecordedRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, req)
+}
+
+const dryRunKey contextKey = readOnlyKey + 1
+
+// WithDryRun marks ctx so that Do records write requests in rec instead of
+// sending them. Safe requests, such as lookups, are still sent.
+func WithDryRun(ctx context.Context, rec *Recorder) context.Context {
+	return context.WithValue(ctx, dryRunKey, rec)
+}
+
+// IsDryRun reports whether ctx was marked with WithDryRun.
+func IsDryRun(ctx context.Context) bool {
+	return dryRunRecorder(ctx) != nil
+}
+
+func dryRunRecorder(ctx context.Context) *Recorder {
+	rec, _ := ctx.Value(dryRunKey).(*Recorder)
+	return rec
+}
+
+// dryRunResponse is returned for a recorded request. Its body tells the
+// tool handler that nothing was sent.
+const dryRunResponse = `{"dryRun": true}`
+
+// recordRequest records req in rec and returns a stand-in response.
+func recordRequest(rec *Recorder, req *http.Request) (*http.Response, error) {
+	recorded := RecordedRequest{
+		Method:  req.Method,
+		URL:     logging.Redact(req.Context(), req.URL.String()),
+		Headers: map[string]string{},
+	}
+	keys := make([]string, 0, len(req.Header))
+	for k := range req.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		value := strings.Join(req.Header.Values(k), ", ")
+		if logging.IsSensitiveKey(k) {
+			value = "[REDACTED]"
+		}
+		recorded.Headers[k] = logging.Redact(req.Context(), value)
+	}
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if len(data) > 0 {
+			recorded.raw = data
+			var body any
+			if json.Unmarshal(data, &body) == nil {
+				recorded.Body = redactValue(req.Context(), body)
+			} else {
+				recorded.Body = logging.Redact(req.Context(), string(data))
+			}
+		}
+	}
+	rec.record(recorded)
+
+	return &http.Response{
+		Status:     "200 OK (dry run)",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader([]byte(dryRunResponse))),
+		Request:    req,
+	}, nil
+}
+
+// redactValue masks credentials in a decoded JSON body, e.g. the password
+// of a new user.
+func redactValue(ctx context.Context, v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, inner := range v {
+			if logging.IsSensitiveKey(k) {
+				v[k] = "[REDACTED]"
+			} else {
+				v[k] = redactValue(ctx, inner)
+			}
+		}
+	case []any:
+		for i, inner := range v {
+			v[i] = redactValue(ctx, inner)
+		}
+	case string:
+		return logging.Redact(ctx, v)
+	}
+	return v
+}
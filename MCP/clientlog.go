@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// clientLoggerName is the logger reported in notifications/message.
+	clientLoggerName = "jira-mcp"
+	// maxLogBacklog is how many warnings logged outside a request are kept
+	// for sessions that set their level later.
+	maxLogBacklog = 50
+)
+
+// clientLogger forwards server log records to MCP clients as
+// notifications/message, honouring the level each session chose with
+// logging/setLevel (error until it does). Records logged while handling a
+// request go to that request's session. Records logged outside a request,
+// such as configuration problems and subscription polls, go to broadcast
+// sessions only, which STDIO mode uses for its single client; the latest
+// warnings among them are replayed when a session sets its level.
+type clientLogger struct {
+	mu        sync.Mutex
+	sessions  map[string]server.ClientSession
+	broadcast map[string]bool
+	backlog   []slog.Record
+}
+
+var clientLogs = &clientLogger{
+	sessions:  map[string]server.ClientSession{},
+	broadcast: map[string]bool{},
+}
+
+// addHooks keeps the logger in sync with the sessions of a server. With
+// broadcast, its sessions also receive records logged outside a request.
+func (l *clientLogger) addHooks(hooks *server.Hooks, broadcast bool) {
+	hooks.AddOnRegisterSession(func(ctx context.Context, session server.ClientSession) {
+		if _, ok := session.(server.SessionWithLogging); !ok {
+			return
+		}
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.sessions[session.SessionID()] = session
+		l.broadcast[session.SessionID()] = broadcast
+	})
+	hooks.AddOnUnregisterSession(func(ctx context.Context, session server.ClientSession) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.sessions, session.SessionID())
+		delete(l.broadcast, session.SessionID())
+	})
+	hooks.AddAfterSetLevel(func(ctx context.Context, id any, message *mcp.SetLevelRequest, result *mcp.EmptyResult) {
+		if session := server.ClientSessionFromContext(ctx); session != nil {
+			l.replay(session)
+		}
+	})
+}
+
+func (l *clientLogger) Enabled(level slog.Level) bool {
+	if level >= slog.LevelWarn {
+		return true // Warnings are kept for the backlog
+	}
+	want := mcpLevel(level)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, session := range l.sessions {
+		if want.ShouldSendTo(session.(server.SessionWithLogging).GetLogLevel()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *clientLogger) Forward(ctx context.Context, r slog.Record) {
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		if srv := server.ServerFromContext(ctx); srv != nil {
+			_ = srv.SendLogMessageToClient(ctx, logNotification(r))
+		}
+		return
+	}
+
+	l.mu.Lock()
+	var targets []server.ClientSession
+	for id, session := range l.sessions {
+		if l.broadcast[id] {
+			targets = append(targets, session)
+		}
+	}
+	if r.Level >= slog.LevelWarn {
+		l.backlog = append(l.backlog, r.Clone())
+		if len(l.backlog) > maxLogBacklog {
+			l.backlog = l.backlog[len(l.backlog)-maxLogBacklog:]
+		}
+	}
+	l.mu.Unlock()
+	for _, session := range targets {
+		sendLog(session, r)
+	}
+}
+
+// replay sends the backlog to a broadcast session that just set its level.
+func (l *clientLogger) replay(session server.ClientSession) {
+	l.mu.Lock()
+	if !l.broadcast[session.SessionID()] {
+		l.mu.Unlock()
+		return
+	}
+	backlog := append([]slog.Record(nil), l.backlog...)
+	l.mu.Unlock()
+	for _, r := range backlog {
+		sendLog(session, r)
+	}
+}
+
+// sendLog sends r to session if its level allows. A full notification
+// channel drops the record rather than block the logger.
+func sendLog(session server.ClientSession, r slog.Record) {
+	leveled, ok := session.(server.SessionWithLogging)
+	if !ok || !session.Initialized() || !mcpLevel(r.Level).ShouldSendTo(leveled.GetLogLevel()) {
+		return
+	}
+	n := logNotification(r)
+	notification := mcp.JSONRPCNotification{
+		JSONRPC: mcp.JSONRPC_VERSION,
+		Notification: mcp.Notification{
+			Method: n.Method,
+			Params: mcp.NotificationParams{AdditionalFields: map[string]any{
+				"level":  n.Params.Level,
+				"logger": n.Params.Logger,
+				"data":   n.Params.Data,
+			}},
+		},
+	}
+	select {
+	case session.NotificationChannel() <- notification:
+	default:
+	}
+}
+
+// logNotification turns a record into notifications/message whose data is
+// the message and the record's attributes, already redacted by the logger.
+func logNotification(r slog.Record) mcp.LoggingMessageNotification {
+	data := map[string]any{"message": r.Message}
+	r.Attrs(func(a slog.Attr) bool {
+		data[a.Key] = a.Value.Resolve().Any()
+		return true
+	})
+	return mcp.NewLoggingMessageNotification(mcpLevel(r.Level), clientLoggerName, data)
+}
+
+// mcpLevel maps a slog level to the nearest MCP logging level.
+func mcpLevel(level slog.Level) mcp.LoggingLevel {
+	switch {
+	case level >= slog.LevelError:
+		return mcp.LoggingLevelError
+	case level >= slog.LevelWarn:
+		return mcp.LoggingLevelWarning
+	case level >= slog.LevelInfo:
+		return mcp.LoggingLevelInfo
+	default:
+		return mcp.LoggingLevelDebug
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strconv"
+)
+
+// writeDocs writes the full Markdown documentation of every tool, keyed by
+// tool name, as a Go source file in the docs package.
+func writeDocs(out string, docs map[string]string) error {
+	names := make([]string, 0, len(docs))
+	for name := range docs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gentools. DO NOT EDIT.\n\n")
+	b.WriteString("package docs\n\n")
+	b.WriteString("var operations = map[string]string{\n")
+	for _, name := range names {
+		if docs[name] == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "\t%q: %s,\n", name, strconv.Quote(docs[name]))
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(out, src, 0o644)
+}
@@ -0,0 +1,63 @@
+// Command gentools post-processes the generated tool files against the
+// swagger spec. It rewrites each tool description from Javadoc HTML into
+// short Markdown, moves the full documentation into the docs package, and
+// wires path parameters into the request URL.
+//
+// It is idempotent and is run through go generate in the docs package.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/jira-7-6-1/mcp-server/operations"
+)
+
+func main() {
+	specPath := flag.String("spec", "../../swagger (6).yaml", "path to the swagger spec")
+	toolsDir := flag.String("tools", "../tools", "directory containing the generated tool packages")
+	docsOut := flag.String("out", "docs_gen.go", "generated documentation file")
+	flag.Parse()
+
+	if err := run(*specPath, *toolsDir, *docsOut); err != nil {
+		fmt.Fprintln(os.Stderr, "gentools:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, toolsDir, docsOut string) error {
+	spec, err := loadSpec(specPath)
+	if err != nil {
+		return err
+	}
+
+	files, err := filepath.Glob(filepath.Join(toolsDir, "*", "*.go"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	docs := map[string]string{}
+	for _, file := range files {
+		if filepath.Base(filepath.Dir(file)) == "meta" {
+			continue // Hand-written meta-tools, not generated from the spec.
+		}
+		legacy, err := rewriteTool(file, spec)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		if legacy == "" {
+			continue
+		}
+		op, ok := operations.ByLegacy(legacy)
+		if !ok {
+			return fmt.Errorf("%s: tool %s has no entry in operations.All", file, legacy)
+		}
+		docs[op.Name] = htmlToMarkdown(spec[legacy].Description)
+	}
+
+	return writeDocs(docsOut, docs)
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// maxDescription caps the tool description sent to clients. Anything longer
+// is left to the on-demand documentation.
+const maxDescription = 300
+
+var (
+	javadocCode = regexp.MustCompile(`\{@code\s+([^}]*)\}`)
+	javadocLink = regexp.MustCompile(`\{@link(?:plain)?\s+([^}\s]*)[^}]*\}`)
+	preBlock    = regexp.MustCompile(`(?is)<pre>(.*?)</pre>`)
+	codeTag     = regexp.MustCompile(`(?is)<(?:code|tt)>(.*?)</(?:code|tt)>`)
+	boldTag     = regexp.MustCompile(`(?is)<(?:b|strong)>(.*?)</(?:b|strong)>`)
+	italicTag   = regexp.MustCompile(`(?is)<(?:i|em)>(.*?)</(?:i|em)>`)
+	anchorTag   = regexp.MustCompile(`(?is)<a\s+[^>]*href="([^"]*)"[^>]*>(.*?)</a>`)
+	headingTag  = regexp.MustCompile(`(?is)<h[1-6][^>]*>(.*?)</h[1-6]>`)
+	breakTag    = regexp.MustCompile(`(?i)</?(?:p|br|ul|ol|dl|div|table)\s*/?>|</(?:dd|dt|tr)>`)
+	itemTag     = regexp.MustCompile(`(?i)<(?:li|dt|dd|tr)[^>]*>`)
+	anyTag      = regexp.MustCompile(`</?[a-zA-Z][^>]*>`)
+	blankLines  = regexp.MustCompile(`\n\s*\n`)
+	spaces      = regexp.MustCompile(`[ \t]+`)
+	placeholder = regexp.MustCompile("\x00(\\d+)\x00")
+	sentenceEnd = regexp.MustCompile(`[.!?]\s+[A-Z(]`)
+)
+
+// htmlToMarkdown converts a Javadoc/HTML description into Markdown.
+// Paragraphs and list items are kept; everything else is reflowed.
+func htmlToMarkdown(s string) string {
+	s = javadocCode.ReplaceAllString(s, "<code>$1</code>")
+	s = javadocLink.ReplaceAllStringFunc(s, func(m string) string {
+		ref := javadocLink.FindStringSubmatch(m)[1]
+		if i := strings.LastIndexAny(ref, ".#"); i >= 0 {
+			ref = ref[i+1:]
+		}
+		return "<code>" + ref + "</code>"
+	})
+
+	var blocks []string
+	s = preBlock.ReplaceAllStringFunc(s, func(m string) string {
+		code := preBlock.FindStringSubmatch(m)[1]
+		code = strings.TrimSpace(html.UnescapeString(anyTag.ReplaceAllString(code, "")))
+		blocks = append(blocks, "```\n"+code+"\n```")
+		return fmt.Sprintf("\n\n\x00%d\x00\n\n", len(blocks)-1)
+	})
+
+	s = codeTag.ReplaceAllStringFunc(s, func(m string) string {
+		return "`" + strings.TrimSpace(codeTag.FindStringSubmatch(m)[1]) + "`"
+	})
+	s = boldTag.ReplaceAllString(s, "**$1**")
+	s = italicTag.ReplaceAllString(s, "*$1*")
+	s = anchorTag.ReplaceAllString(s, "[$2]($1)")
+	s = headingTag.ReplaceAllString(s, "\n\n**$1**\n\n")
+	s = itemTag.ReplaceAllString(s, "\n\n- ")
+	s = breakTag.ReplaceAllString(s, "\n\n")
+	s = anyTag.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+
+	var paragraphs []string
+	for _, p := range blankLines.Split(s, -1) {
+		p = strings.TrimSpace(spaces.ReplaceAllString(strings.ReplaceAll(p, "\n", " "), " "))
+		if p != "" && p != "-" {
+			paragraphs = append(paragraphs, p)
+		}
+	}
+	md := joinParagraphs(paragraphs)
+	return placeholder.ReplaceAllStringFunc(md, func(m string) string {
+		var i int
+		fmt.Sscanf(strings.Trim(m, "\x00"), "%d", &i)
+		return blocks[i]
+	})
+}
+
+// joinParagraphs separates paragraphs with blank lines but keeps
+// consecutive list items together.
+func joinParagraphs(paragraphs []string) string {
+	var b strings.Builder
+	for i, p := range paragraphs {
+		if i > 0 {
+			if strings.HasPrefix(p, "- ") && strings.HasPrefix(paragraphs[i-1], "- ") {
+				b.WriteString("\n")
+			} else {
+				b.WriteString("\n\n")
+			}
+		}
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+// shortDescription returns the tool description: the first paragraph of
+// the Markdown documentation, cut back to its first sentence when it is too
+// long, and never longer than maxDescription.
+func shortDescription(md string) string {
+	first, _, _ := strings.Cut(md, "\n\n")
+	if strings.HasPrefix(first, "```") {
+		first = ""
+	}
+	first = strings.TrimPrefix(first, "- ")
+	if len(first) > maxDescription {
+		first = firstSentence(first)
+	}
+	if len(first) > maxDescription {
+		first = strings.TrimSpace(first[:maxDescription-3]) + "..."
+	}
+	return first
+}
+
+// firstSentence returns text up to and including the first full stop that
+// is followed by the start of another sentence.
+func firstSentence(text string) string {
+	for _, loc := range sentenceEnd.FindAllStringIndex(text, -1) {
+		candidate := text[:loc[0]+1]
+		if strings.HasSuffix(candidate, "e.g.") || strings.HasSuffix(candidate, "i.e.") {
+			continue
+		}
+		return candidate
+	}
+	return text
+}
+
+// cleanParameter flattens a parameter description to a single Markdown line.
+func cleanParameter(description string) string {
+	md := htmlToMarkdown(description)
+	md = strings.Join(strings.Fields(md), " ")
+	if len(md) > maxDescription {
+		md = firstSentence(md)
+	}
+	return md
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	toolNamePattern    = regexp.MustCompile(`mcp\.NewTool\("([^"]+)",`)
+	descriptionPattern = regexp.MustCompile(`(?s)(\t\tmcp\.WithDescription\()".*?"\),\n(\t\tmcp\.With|\t\))`)
+	parameterPattern   = regexp.MustCompile(`(?m)^(\t\tmcp\.With\w+\("([^"]+)", )(mcp\.Required\(\), )?mcp\.Description\(("(?:[^"\\]|\\.)*")\)\),$`)
+	urlPattern         = regexp.MustCompile(`(?m)^\t\turl := fmt\.Sprintf\(("[^"]*"), cfg\.BaseURL(, queryString)?\)$`)
+	queryPattern       = regexp.MustCompile(`fmt\.Sprintf\("([^"=]+)=%v", val\)`)
+	netURLImport       = regexp.MustCompile(`(?m)^\t"net/url"$`)
+)
+
+// docsHint is appended to descriptions that were shortened.
+const docsHint = " Full documentation: describe_jira_operation."
+
+// rewriteTool rewrites one tool file in place and returns the legacy tool
+// name it defines, or "" when the file defines no tool.
+func rewriteTool(file string, spec map[string]specOperation) (string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	src := string(data)
+
+	m := toolNamePattern.FindStringSubmatch(src)
+	if m == nil {
+		return "", nil
+	}
+	legacy := m[1]
+	op, ok := spec[legacy]
+	if !ok {
+		return "", fmt.Errorf("tool %s not found in spec", legacy)
+	}
+
+	src, err = rewriteDescription(src, op)
+	if err != nil {
+		return "", err
+	}
+	src = rewriteParameters(src, op)
+	src, err = wirePathParams(src, op)
+	if err != nil {
+		return "", err
+	}
+	src = escapeQuery(src)
+	if strings.Contains(src, "url.PathEscape(") || strings.Contains(src, "url.QueryEscape(") {
+		src = importNetURL(src)
+	}
+
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		return "", fmt.Errorf("format: %w", err)
+	}
+	if string(formatted) == string(data) {
+		return legacy, nil
+	}
+	return legacy, os.WriteFile(file, formatted, 0o644)
+}
+
+func rewriteDescription(src string, op specOperation) (string, error) {
+	loc := descriptionPattern.FindStringSubmatchIndex(src)
+	if loc == nil {
+		return "", fmt.Errorf("no tool description found")
+	}
+	md := htmlToMarkdown(op.Description)
+	short := shortDescription(md)
+	if short != md && short != "" {
+		short += docsHint
+	}
+	if short == "" {
+		short = op.Method + " " + op.Path
+	}
+	return src[:loc[0]] + src[loc[2]:loc[3]] + strconv.Quote(short) + "),\n" + src[loc[4]:loc[5]] + src[loc[1]:], nil
+}
+
+func rewriteParameters(src string, op specOperation) string {
+	return parameterPattern.ReplaceAllStringFunc(src, func(line string) string {
+		m := parameterPattern.FindStringSubmatch(line)
+		description, ok := op.Params[m[2]]
+		if !ok {
+			description, _ = strconv.Unquote(m[4])
+		}
+		return m[1] + m[3] + "mcp.Description(" + strconv.Quote(cleanParameter(description)) + ")),"
+	})
+}
+
+// wirePathParams makes every path parameter a required string argument and
+// substitutes it, escaped, into the request URL. Files that are already
+// wired are left alone.
+func wirePathParams(src string, op specOperation) (string, error) {
+	if len(op.PathParams) == 0 {
+		return src, nil
+	}
+	m := urlPattern.FindStringSubmatchIndex(src)
+	if m == nil {
+		if strings.Contains(src, "url.PathEscape(") {
+			return src, nil
+		}
+		return "", fmt.Errorf("request URL not found")
+	}
+	format, _ := strconv.Unquote(src[m[2]:m[3]])
+	query := m[4] >= 0
+
+	var lookups, args strings.Builder
+	for _, name := range op.PathParams {
+		ident := goIdent(name)
+		fmt.Fprintf(&lookups, "\t\t%s, err := request.RequireString(%q)\n", ident, name)
+		lookups.WriteString("\t\tif err != nil {\n\t\t\treturn mcp.NewToolResultError(err.Error()), nil\n\t\t}\n")
+		fmt.Fprintf(&args, ", url.PathEscape(%s)", ident)
+	}
+	if query {
+		args.WriteString(", queryString")
+	}
+	if want := strings.Count(format, "%s") - 1; want != len(op.PathParams)+boolInt(query) {
+		return "", fmt.Errorf("URL format %q does not match path parameters %v", format, op.PathParams)
+	}
+	line := fmt.Sprintf("\t\turl := fmt.Sprintf(%q, cfg.BaseURL%s)", format, args.String())
+	src = src[:m[0]] + lookups.String() + line + src[m[1]:]
+
+	// Declare the path parameters on the tool, ahead of the query parameters.
+	var params strings.Builder
+	for _, name := range op.PathParams {
+		fmt.Fprintf(&params, "\t\tmcp.WithString(%q, mcp.Required(), mcp.Description(%q)),\n", name, cleanParameter(op.Params[name]))
+	}
+	loc := descriptionPattern.FindStringSubmatchIndex(src)
+	insertAt := loc[4]
+	return src[:insertAt] + params.String() + src[insertAt:], nil
+}
+
+// escapeQuery URL-encodes query parameter values, so JQL and other free
+// text survive the trip to Jira.
+func escapeQuery(src string) string {
+	return queryPattern.ReplaceAllString(src, `"$1="+url.QueryEscape(fmt.Sprint(val))`)
+}
+
+func importNetURL(src string) string {
+	if netURLImport.MatchString(src) {
+		return src
+	}
+	return strings.Replace(src, "\t\"net/http\"\n", "\t\"net/http\"\n\t\"net/url\"\n", 1)
+}
+
+// goIdent turns a path parameter name into a Go identifier that does not
+// collide with keywords or the url package.
+func goIdent(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper && b.Len() > 0 {
+			r = unicode.ToUpper(r)
+		}
+		upper = false
+		b.WriteRune(r)
+	}
+	switch ident := b.String(); ident {
+	case "type", "func", "default", "range", "url", "err", "req", "resp", "body", "result", "args", "cfg", "request", "ctx":
+		return ident + "Param"
+	default:
+		return ident
+	}
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
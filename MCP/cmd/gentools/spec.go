@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// specOperation is the part of a swagger operation the generator needs.
+type specOperation struct {
+	Method      string
+	Path        string
+	Description string
+	Params      map[string]string // Parameter name to description
+	PathParams  []string          // Path parameters in URL order
+}
+
+type swagger struct {
+	Paths map[string]map[string]yaml.Node `yaml:"paths"`
+}
+
+type swaggerOperation struct {
+	Description string             `yaml:"description"`
+	Parameters  []swaggerParameter `yaml:"parameters"`
+}
+
+type swaggerParameter struct {
+	Name        string `yaml:"name"`
+	In          string `yaml:"in"`
+	Description string `yaml:"description"`
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// loadSpec reads the swagger spec and indexes its operations by the
+// generated tool name, e.g. get_api_2_issue_issueIdOrKey.
+func loadSpec(path string) (map[string]specOperation, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc swagger
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	spec := map[string]specOperation{}
+	for path, item := range doc.Paths {
+		var shared []swaggerParameter
+		if node, ok := item["parameters"]; ok {
+			if err := node.Decode(&shared); err != nil {
+				return nil, fmt.Errorf("%s parameters: %w", path, err)
+			}
+		}
+		// Path-level parameters are shared by every method on the path, but
+		// their descriptions were written for one of them ("the issue id or
+		// key to update" on GET and DELETE too). Describe them from the path
+		// instead when several methods share them.
+		if len(item) > 2 {
+			for i := range shared {
+				shared[i].Description = pathParamDescription(path, shared[i].Name)
+			}
+		}
+		for method, node := range item {
+			if method == "parameters" {
+				continue
+			}
+			var op swaggerOperation
+			if err := node.Decode(&op); err != nil {
+				return nil, fmt.Errorf("%s %s: %w", method, path, err)
+			}
+			params := map[string]string{}
+			for _, p := range append(append([]swaggerParameter{}, shared...), op.Parameters...) {
+				params[p.Name] = p.Description
+			}
+			var pathParams []string
+			for _, m := range pathParamPattern.FindAllStringSubmatch(path, -1) {
+				pathParams = append(pathParams, m[1])
+			}
+			spec[toolName(method, path)] = specOperation{
+				Method:      strings.ToUpper(method),
+				Path:        path,
+				Description: op.Description,
+				Params:      params,
+				PathParams:  pathParams,
+			}
+		}
+	}
+	return spec, nil
+}
+
+// toolName reproduces the generated tool naming: method plus the path with
+// slashes turned into underscores and parameter braces dropped.
+func toolName(method, path string) string {
+	path = strings.Trim(path, "/")
+	path = strings.NewReplacer("{", "", "}", "", "/", "_").Replace(path)
+	return method + "_" + path
+}
+
+// pathParamDescription describes a path parameter from its name, e.g.
+// issueIdOrKey becomes "the issue id or key". A bare name such as id takes
+// the path segment before it: /api/2/component/{id} gives "the component id".
+func pathParamDescription(path, name string) string {
+	var words []string
+	start := 0
+	for i, r := range name {
+		if unicode.IsUpper(r) || r == '-' || r == '_' {
+			if i > start {
+				words = append(words, strings.ToLower(name[start:i]))
+			}
+			start = i
+			if r == '-' || r == '_' {
+				start++
+			}
+		}
+	}
+	words = append(words, strings.ToLower(name[start:]))
+
+	if len(words) == 1 {
+		segments := strings.Split(path, "/")
+		for i, segment := range segments {
+			if segment == "{"+name+"}" && i > 0 && !strings.HasPrefix(segments[i-1], "{") {
+				words = append([]string{segments[i-1]}, words...)
+				break
+			}
+		}
+	}
+	return "the " + strings.Join(words, " ")
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// addCommentTool gives jira_add_issue_comment a body in Markdown or wiki
+// markup. The generated tool sends no request body, so its handler is
+// replaced rather than wrapped.
+func addCommentTool(tool models.Tool, cfg *config.APIConfig) models.Tool {
+	tool.Definition = mcp.NewTool(tool.Definition.Name,
+		mcp.WithDescription("Adds a comment to an issue. The body is written in Markdown and sent as Jira wiki markup, keeping headings, emphasis, links, mentions ([~username]), lists, tables, code blocks and panels (> [!INFO] Title). Full documentation: describe_jira_operation."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("key or id of the issue, e.g. ABC-123")),
+		mcp.WithString("body", mcp.Required(), mcp.Description("text of the comment")),
+		withMarkup(),
+		mcp.WithString("expand", mcp.Description("optional flags: renderedBody (provides body rendered in HTML)")),
+	)
+	tool.Handler = commentHandler(cfg, "POST")
+	return tool
+}
+
+// updateCommentTool gives jira_update_issue_comment a body like addCommentTool.
+func updateCommentTool(tool models.Tool, cfg *config.APIConfig) models.Tool {
+	tool.Definition = mcp.NewTool(tool.Definition.Name,
+		mcp.WithDescription("Replaces the text of a comment. The body is written in Markdown and sent as Jira wiki markup, as for jira_add_issue_comment. Full documentation: describe_jira_operation."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("key or id of the issue the comment belongs to")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("id of the comment")),
+		mcp.WithString("body", mcp.Required(), mcp.Description("new text of the comment")),
+		withMarkup(),
+		mcp.WithString("expand", mcp.Description("optional flags: renderedBody (provides body rendered in HTML)")),
+	)
+	tool.Handler = commentHandler(cfg, "PUT")
+	return tool
+}
+
+// commentHandler adds a comment with POST or updates the comment given by
+// id with PUT.
+func commentHandler(cfg *config.APIConfig, method string) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		key, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		body, err := request.RequireString("body")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		path := "/api/2/issue/" + url.PathEscape(key) + "/comment"
+		if method == "PUT" {
+			id, err := request.RequireString("id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path += "/" + url.PathEscape(id)
+		}
+		if expand := request.GetString("expand", ""); expand != "" {
+			path += "?expand=" + url.QueryEscape(expand)
+		}
+
+		data, err := jiraRequest(ctx, cfg, method, path, map[string]any{"body": toWiki(request, body)})
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		var result map[string]any
+		if err := json.Unmarshal(data, &result); err != nil {
+			return mcp.NewToolResultText(string(data)), nil
+		}
+		logging.FromContext(ctx).Info("comment saved", "issue", key, "method", method, "comment", result["id"])
+		pretty, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+		}
+		return mcp.NewToolResultText(string(pretty)), nil
+	}
+}
@@ -0,0 +1,336 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	tools_api "github.com/jira-7-6-1/mcp-server/tools/api"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// completionTTL is how long lookups behind completions are reused.
+	completionTTL = time.Minute
+	// maxCompletions is the most values a completion may return.
+	maxCompletions = 100
+)
+
+// completionKinds maps prompt argument and resource template variable names
+// to the Jira lookup that completes them.
+var completionKinds = map[string]string{
+	"projectKey":     "project",
+	"projectIdOrKey": "project",
+	"issueTypeId":    "issuetype",
+	"issuetypeId":    "issuetype",
+	"username":       "user",
+	"assignee":       "user",
+	"reporter":       "user",
+	"fieldId":        "field",
+	"field":          "field",
+	"jql":            "jql",
+}
+
+// resourceCompletionKinds names the lookup for template variables whose name
+// alone is ambiguous, keyed by URI template and variable.
+var resourceCompletionKinds = map[string]string{
+	"jira://project/{key}#key": "project",
+}
+
+// completer answers completion/complete for prompt arguments and resource
+// template variables with values looked up in Jira.
+type completer struct {
+	cfg         *config.APIConfig
+	projects    server.ToolHandlerFunc
+	issueTypes  server.ToolHandlerFunc
+	fields      server.ToolHandlerFunc
+	users       server.ToolHandlerFunc
+	suggestions server.ToolHandlerFunc
+}
+
+func newCompleter(cfg *config.APIConfig) *completer {
+	return &completer{
+		cfg:         cfg,
+		projects:    tools_api.GetallprojectsHandler(cfg),
+		issueTypes:  tools_api.GetissuealltypesHandler(cfg),
+		fields:      tools_api.GetfieldsHandler(cfg),
+		users:       tools_api.FindusersforpickerHandler(cfg),
+		suggestions: tools_api.GetfieldautocompleteforquerystringHandler(cfg),
+	}
+}
+
+func (c *completer) CompletePromptArgument(ctx context.Context, promptName string, argument mcp.CompleteArgument, _ mcp.CompleteContext) (*mcp.Completion, error) {
+	return c.complete(ctx, completionKinds[argument.Name], argument.Value)
+}
+
+func (c *completer) CompleteResourceArgument(ctx context.Context, uri string, argument mcp.CompleteArgument, _ mcp.CompleteContext) (*mcp.Completion, error) {
+	kind, ok := resourceCompletionKinds[uri+"#"+argument.Name]
+	if !ok {
+		kind = completionKinds[argument.Name]
+	}
+	return c.complete(ctx, kind, argument.Value)
+}
+
+func (c *completer) complete(ctx context.Context, kind, value string) (*mcp.Completion, error) {
+	ctx = logging.WithRequestID(ctx, logging.NewRequestID())
+	var values []string
+	var err error
+	switch kind {
+	case "project":
+		values, err = c.completeProjects(ctx, value)
+	case "issuetype":
+		values, err = c.completeIssueTypes(ctx, value)
+	case "user":
+		values, err = c.completeUsers(ctx, value)
+	case "field":
+		values, err = c.completeFields(ctx, value)
+	case "jql":
+		values, err = c.completeJQL(ctx, value)
+	}
+	if err != nil {
+		// A failed lookup should not break the client's input box.
+		logging.FromContext(ctx).Warn("completion lookup failed", "kind", kind, "error", err)
+		return &mcp.Completion{Values: []string{}}, nil
+	}
+	return completion(values), nil
+}
+
+func (c *completer) completeProjects(ctx context.Context, prefix string) ([]string, error) {
+	var projects []struct {
+		Key  string `json:"key"`
+		Name string `json:"name"`
+	}
+	if err := c.lookup(ctx, c.projects, "jira_list_projects", nil, &projects); err != nil {
+		return nil, err
+	}
+	var values []string
+	for _, p := range projects {
+		if matches(prefix, p.Key, p.Name) {
+			values = append(values, p.Key)
+		}
+	}
+	return values, nil
+}
+
+func (c *completer) completeIssueTypes(ctx context.Context, prefix string) ([]string, error) {
+	var types []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := c.lookup(ctx, c.issueTypes, "jira_list_issue_types", nil, &types); err != nil {
+		return nil, err
+	}
+	var values []string
+	for _, t := range types {
+		if matches(prefix, t.ID, t.Name) {
+			values = append(values, t.ID)
+		}
+	}
+	return values, nil
+}
+
+func (c *completer) completeUsers(ctx context.Context, prefix string) ([]string, error) {
+	if prefix == "" {
+		return nil, nil
+	}
+	var picker struct {
+		Users []struct {
+			Name string `json:"name"`
+		} `json:"users"`
+	}
+	args := map[string]any{"query": prefix, "maxResults": 20, "showAvatar": false}
+	if err := c.lookup(ctx, c.users, "jira_find_users_for_picker", args, &picker); err != nil {
+		return nil, err
+	}
+	values := make([]string, 0, len(picker.Users))
+	for _, u := range picker.Users {
+		values = append(values, u.Name)
+	}
+	return values, nil
+}
+
+// jiraField is the part of a field definition in /api/2/field that
+// completions and field name lookups use.
+type jiraField struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	ClauseNames []string    `json:"clauseNames"`
+	Schema      fieldSchema `json:"schema"`
+}
+
+func (c *completer) completeFields(ctx context.Context, prefix string) ([]string, error) {
+	var fields []jiraField
+	if err := c.lookup(ctx, c.fields, "jira_list_fields", nil, &fields); err != nil {
+		return nil, err
+	}
+	var values []string
+	for _, f := range fields {
+		if matches(prefix, f.ID, f.Name) {
+			values = append(values, f.ID)
+		}
+	}
+	return values, nil
+}
+
+// jqlValueClause matches a JQL query that ends in a field value being
+// typed, e.g. `project = AB` or `status = "In Pro`.
+var jqlValueClause = regexp.MustCompile(`(?i)([\w.]+|"[^"]+")\s*(?:=|!=|~|!~|\bin\s*\(|\bnot\s+in\s*\()\s*(?:"([^"]*)|([^\s"(),]*))$`)
+
+// jqlWord matches the partial word at the end of a JQL query.
+var jqlWord = regexp.MustCompile(`[\w.]*$`)
+
+// completeJQL completes the value of the last clause of a JQL query with
+// Jira's autocomplete suggestions, or the field name being typed. Values are
+// the whole query with the completion applied.
+func (c *completer) completeJQL(ctx context.Context, jql string) ([]string, error) {
+	if m := jqlValueClause.FindStringSubmatchIndex(jql); m != nil {
+		field := strings.Trim(jql[m[2]:m[3]], `"`)
+		start, end := m[6], m[7] // Unquoted value
+		if m[4] >= 0 {
+			start, end = m[4]-1, m[5] // Quoted value, including the quote
+		}
+		partial := strings.TrimPrefix(jql[start:end], `"`)
+		var suggestions struct {
+			Results []struct {
+				Value string `json:"value"`
+			} `json:"results"`
+		}
+		args := map[string]any{"fieldName": field, "fieldValue": partial}
+		if err := c.lookup(ctx, c.suggestions, "jira_get_jql_autocomplete_suggestions", args, &suggestions); err != nil {
+			return nil, err
+		}
+		head := jql[:start]
+		values := make([]string, 0, len(suggestions.Results))
+		for _, s := range suggestions.Results {
+			values = append(values, head+jqlValue(s.Value))
+		}
+		return values, nil
+	}
+
+	word := jqlWord.FindString(jql)
+	if word == "" {
+		return nil, nil
+	}
+	var fields []jiraField
+	if err := c.lookup(ctx, c.fields, "jira_list_fields", nil, &fields); err != nil {
+		return nil, err
+	}
+	head := jql[:len(jql)-len(word)]
+	seen := map[string]bool{}
+	var values []string
+	for _, f := range fields {
+		for _, clause := range f.ClauseNames {
+			if !seen[clause] && matches(word, clause) {
+				seen[clause] = true
+				values = append(values, head+jqlValue(clause))
+			}
+		}
+	}
+	return values, nil
+}
+
+// jqlValue quotes a JQL value or field name when it is not a plain word.
+func jqlValue(s string) string {
+	if jqlWord.FindString(s) == s && s != "" {
+		return s
+	}
+	return jqlString(s)
+}
+
+// lookup calls a tool handler, or reuses a result younger than
+// completionTTL, and decodes its JSON output into v.
+func (c *completer) lookup(ctx context.Context, handler server.ToolHandlerFunc, tool string, args map[string]any, v any) error {
+	key, err := cacheKey(c.cfg, tool, args)
+	if err != nil {
+		return err
+	}
+	text, ok := completionCache.get(key)
+	if !ok {
+		text, err = callTool(logging.WithToolName(ctx, tool), handler, tool, args)
+		if err != nil {
+			return fmt.Errorf("%s: %w", tool, err)
+		}
+		completionCache.put(key, text)
+	}
+	return json.Unmarshal([]byte(text), v)
+}
+
+// cacheKey identifies a lookup. It includes the Jira instance and a hash of
+// the credentials so that HTTP sessions never share each other's results.
+func cacheKey(cfg *config.APIConfig, tool string, args map[string]any) (string, error) {
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(cfg.BearerToken + "\x00" + cfg.APIKey + "\x00" + cfg.BasicAuth))
+	return cfg.BaseURL + "\x00" + hex.EncodeToString(sum[:8]) + "\x00" + tool + "\x00" + string(encoded), nil
+}
+
+type cacheEntry struct {
+	text    string
+	expires time.Time
+}
+
+// ttlCache is a small map of lookup results that expire after completionTTL.
+// It is shared by all servers because HTTP mode creates one per request.
+type ttlCache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+var completionCache = &ttlCache{entries: map[string]cacheEntry{}}
+
+func (c *ttlCache) get(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		return "", false
+	}
+	return e.text, true
+}
+
+func (c *ttlCache) put(key, text string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for k, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = cacheEntry{text: text, expires: now.Add(completionTTL)}
+}
+
+// matches reports whether any candidate starts with prefix, ignoring case.
+func matches(prefix string, candidates ...string) bool {
+	prefix = strings.ToLower(prefix)
+	for _, c := range candidates {
+		if strings.HasPrefix(strings.ToLower(c), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// completion caps values at maxCompletions and reports the rest.
+func completion(values []string) *mcp.Completion {
+	if values == nil {
+		values = []string{}
+	}
+	result := &mcp.Completion{Values: values, Total: len(values)}
+	if len(values) > maxCompletions {
+		result.Values = values[:maxCompletions]
+		result.HasMore = true
+	}
+	return result
+}
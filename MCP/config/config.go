@@ -3,14 +3,34 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type APIConfig struct {
 	BaseURL     string
-	BearerToken string // For OAuth2/Bearer authentication
-	APIKey      string // For API key authentication
-	BasicAuth   string // For basic authentication
-	Port        string // For server port configuration
+	BearerToken string   // For OAuth2/Bearer authentication
+	APIKey      string   // For API key authentication
+	BasicAuth   string   // For basic authentication
+	Port        string   // For server port configuration
+	LogLevel    string   // debug, info, warn or error
+	LogFormat   string   // json or text
+	ReadOnly    bool     // Only register and allow non-mutating (GET) tools
+	ToolGroups  []string // Named tool groups to register, e.g. issues, projects
+	AllowTools  []string // Tool name globs to register in addition to ToolGroups
+	DenyTools   []string // Tool name globs never to register
+	LazyTools   bool     // Register only the search/describe/invoke meta-tools
+
+	ConfirmDestructive bool // Ask the user before deleting projects, issues, versions and the like
+	DryRun             bool // Record write requests instead of sending them
+
+	PollInterval  time.Duration // How often subscribed issues are checked for updates
+	PollBatchSize int           // Issue keys per update search
+
+	MaxResponseBytes int // Tool results above this size are truncated; 0 means no limit
+
+	Problems []string // Settings that were invalid and replaced by defaults
 }
 
 func LoadAPIConfig() (*APIConfig, error) {
@@ -19,30 +39,162 @@ func LoadAPIConfig() (*APIConfig, error) {
 	if port == "" {
 		port = os.Getenv("port")
 	}
-	
+
 	baseURL := os.Getenv("API_BASE_URL")
-	
+
 	// Check transport environment variable (both uppercase and lowercase)
 	transport := os.Getenv("TRANSPORT")
 	if transport == "" {
 		transport = os.Getenv("transport")
 	}
-	
+
 	// For STDIO mode (transport is not "http"/"HTTP"/"https"/"HTTPS"), API_BASE_URL is required from environment
 	if transport != "http" && transport != "HTTP" && transport != "https" && transport != "HTTPS" && baseURL == "" {
 		return nil, fmt.Errorf("API_BASE_URL environment variable not set")
 	}
-	
+
 	// For HTTP/HTTPS mode (transport is "http"/"HTTP"/"https"/"HTTPS"), API_BASE_URL comes from headers
 	// so we don't require it from environment variables
 
-	return &APIConfig{
+	cfg := &APIConfig{
 		BaseURL:     baseURL,
 		BearerToken: os.Getenv("BEARER_TOKEN"),
 		APIKey:      os.Getenv("API_KEY"),
 		BasicAuth:   os.Getenv("BASIC_AUTH"),
 		Port:        port,
-	}, nil
+		LogLevel:    os.Getenv("LOG_LEVEL"),
+		LogFormat:   os.Getenv("LOG_FORMAT"),
+		ReadOnly:    ParseBool(os.Getenv("READ_ONLY")),
+		ToolGroups:  ParseList(os.Getenv("TOOL_GROUPS")),
+		AllowTools:  ParseList(os.Getenv("TOOLS_ALLOW")),
+		DenyTools:   ParseList(os.Getenv("TOOLS_DENY")),
+		LazyTools:   ParseBool(os.Getenv("LAZY_TOOLS")),
+
+		ConfirmDestructive: os.Getenv("CONFIRM_DESTRUCTIVE") == "" || ParseBool(os.Getenv("CONFIRM_DESTRUCTIVE")),
+		DryRun:             ParseBool(os.Getenv("DRY_RUN")),
+
+		PollInterval:  parseDuration(os.Getenv("SUBSCRIPTION_POLL_INTERVAL"), time.Minute),
+		PollBatchSize: parseInt(os.Getenv("SUBSCRIPTION_BATCH_SIZE"), 50),
+
+		MaxResponseBytes: DefaultMaxResponseBytes,
+	}
+	if v := os.Getenv("MAX_RESPONSE_SIZE"); v != "" {
+		if n, ok := ParseSize(v); ok {
+			cfg.MaxResponseBytes = n
+		}
+	}
+	cfg.Problems = checkSettings()
+	return cfg, nil
 }
 
+// checkSettings lists the environment settings that are set but invalid, so
+// that falling back to their defaults does not go unnoticed.
+func checkSettings() []string {
+	var problems []string
+	invalid := func(name, want string) {
+		problems = append(problems, fmt.Sprintf("%s=%q is not %s; using the default", name, os.Getenv(name), want))
+	}
+	if v := os.Getenv("LOG_LEVEL"); v != "" && !oneOf(v, "debug", "info", "warn", "warning", "error") {
+		invalid("LOG_LEVEL", "debug, info, warn or error")
+	}
+	if v := os.Getenv("LOG_FORMAT"); v != "" && !oneOf(v, "json", "text") {
+		invalid("LOG_FORMAT", "json or text")
+	}
+	if v := os.Getenv("SUBSCRIPTION_POLL_INTERVAL"); v != "" && parseDuration(v, 0) == 0 {
+		invalid("SUBSCRIPTION_POLL_INTERVAL", "a positive duration such as 30s")
+	}
+	if v := os.Getenv("SUBSCRIPTION_BATCH_SIZE"); v != "" && parseInt(v, 0) == 0 {
+		invalid("SUBSCRIPTION_BATCH_SIZE", "a positive number")
+	}
+	if v := os.Getenv("MAX_RESPONSE_SIZE"); v != "" {
+		if _, ok := ParseSize(v); !ok {
+			invalid("MAX_RESPONSE_SIZE", "a size such as 25000 tokens, 100KB or 0")
+		}
+	}
+	return problems
+}
 
+func oneOf(value string, allowed ...string) bool {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for _, a := range allowed {
+		if value == a {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseList splits a comma-separated setting into trimmed, non-empty items.
+func ParseList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ParseBool interprets common truthy spellings ("true", "1", "yes", "on").
+// Anything else, including an empty string, is false.
+func ParseBool(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "1", "t", "true", "y", "yes", "on":
+		return true
+	}
+	return false
+}
+
+// BytesPerToken is the rough number of bytes per model token used to turn a
+// size in tokens into bytes.
+const BytesPerToken = 4
+
+// DefaultMaxResponseBytes is the response limit when MAX_RESPONSE_SIZE is
+// not set, about 25000 tokens.
+const DefaultMaxResponseBytes = 25000 * BytesPerToken
+
+// ParseSize reads a response size in tokens ("25000 tokens"), kilobytes
+// ("100KB"), megabytes ("1MB") or bytes ("100000", "100000 bytes") and
+// returns it in bytes. 0 turns the limit off.
+func ParseSize(value string) (int, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	units := []struct {
+		suffix string
+		bytes  int
+	}{
+		{"tokens", BytesPerToken}, {"token", BytesPerToken},
+		{"kb", 1024}, {"mb", 1024 * 1024},
+		{"bytes", 1}, {"b", 1},
+	}
+	multiplier := 1
+	for _, u := range units {
+		if strings.HasSuffix(value, u.suffix) {
+			value, multiplier = strings.TrimSpace(strings.TrimSuffix(value, u.suffix)), u.bytes
+			break
+		}
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return n * multiplier, true
+}
+
+// parseDuration reads a Go duration such as "30s", falling back to def when
+// value is empty, malformed or not positive.
+func parseDuration(value string, def time.Duration) time.Duration {
+	d, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil || d <= 0 {
+		return def
+	}
+	return d
+}
+
+// parseInt reads a positive integer, falling back to def.
+func parseInt(value string, def int) int {
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || n <= 0 {
+		return def
+	}
+	return n
+}
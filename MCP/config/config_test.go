@@ -0,0 +1,31 @@
+package config
+
+import "testing"
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int
+		ok   bool
+	}{
+		{"2048", 2048, true},
+		{"100b", 100, true},
+		{"100 bytes", 100, true},
+		{"64KB", 64 * 1024, true},
+		{" 2 mb ", 2 * 1024 * 1024, true},
+		{"1000 tokens", 1000 * BytesPerToken, true},
+		{"1token", BytesPerToken, true},
+		{"0", 0, true},
+		{"", 0, false},
+		{"-5kb", 0, false},
+		{"1.5mb", 0, false},
+		{"10gb", 0, false},
+		{"lots", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseSize(tt.in)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ParseSize(%q) = %d, %v, want %d, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
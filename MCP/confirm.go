@@ -0,0 +1,346 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jira-7-6-1/mcp-server/client"
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	tools_api "github.com/jira-7-6-1/mcp-server/tools/api"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// confirmationCodeTTL is how long a confirmation code from the fallback flow is valid.
+const confirmationCodeTTL = 5 * time.Minute
+
+// confirmation describes how to show the target of a destructive tool to
+// the user before it runs.
+type confirmation struct {
+	action     string // What the tool does, e.g. "Delete version"
+	reversible bool   // Whether the change can be undone
+	// lookup resolves the target through a read-only tool so that the user
+	// sees what will be changed, and a made-up id fails before anything is
+	// deleted. Optional.
+	lookup     func(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)
+	lookupTool string
+	lookupArgs func(args map[string]any) map[string]any
+}
+
+// confirmations are the destructive tools that need the user's consent,
+// keyed by generated tool name.
+var confirmations = map[string]confirmation{
+	"delete_api_2_project_projectIdOrKey": {
+		action: "Delete project", lookup: tools_api.Get_api_2_project_projectidorkeyHandler, lookupTool: "jira_get_project",
+		lookupArgs: pass("projectIdOrKey"),
+	},
+	"delete_api_2_issue_issueIdOrKey": {
+		action: "Delete issue", lookup: tools_api.GetissueHandler, lookupTool: "jira_get_issue",
+		lookupArgs: func(args map[string]any) map[string]any {
+			return map[string]any{"issueIdOrKey": args["issueIdOrKey"], "fields": "summary,issuetype,status,project"}
+		},
+	},
+	"delete_api_2_version_id": {
+		action: "Delete version", lookup: tools_api.GetversionHandler, lookupTool: "jira_get_version",
+		lookupArgs: pass("id"),
+	},
+	"post_api_2_version_id_removeAndSwap": {
+		action: "Delete version and move its issues", lookup: tools_api.GetversionHandler, lookupTool: "jira_get_version",
+		lookupArgs: pass("id"),
+	},
+	"delete_api_2_component_id": {
+		action: "Delete component", lookup: tools_api.GetcomponentHandler, lookupTool: "jira_get_component",
+		lookupArgs: pass("id"),
+	},
+	"delete_api_2_filter_id": {
+		action: "Delete filter", lookup: tools_api.GetfilterHandler, lookupTool: "jira_get_filter",
+		lookupArgs: pass("id"),
+	},
+	"delete_api_2_permissionscheme_schemeId": {
+		action: "Delete permission scheme", lookup: tools_api.GetpermissionschemeHandler, lookupTool: "jira_get_permission_scheme",
+		lookupArgs: pass("schemeId"),
+	},
+	"delete_api_2_workflowscheme_id": {
+		action: "Delete workflow scheme", lookup: tools_api.GetbyidHandler, lookupTool: "jira_get_workflow_scheme",
+		lookupArgs: pass("id"),
+	},
+	"delete_api_2_group": {
+		action: "Delete group", lookup: tools_api.GetgroupHandler, lookupTool: "jira_get_group",
+		lookupArgs: pass("groupname"),
+	},
+	"delete_api_2_user": {
+		action: "Delete user", lookup: tools_api.Get_api_2_userHandler, lookupTool: "jira_get_user",
+		lookupArgs: pass("username", "key"),
+	},
+	"put_api_2_settings_baseUrl": {
+		action: "Change the Jira base URL", reversible: true, lookup: tools_api.GetserverinfoHandler, lookupTool: "jira_get_server_info",
+		lookupArgs: func(map[string]any) map[string]any { return nil },
+	},
+}
+
+// pass returns a lookupArgs function that copies the named arguments.
+func pass(names ...string) func(args map[string]any) map[string]any {
+	return func(args map[string]any) map[string]any {
+		out := map[string]any{}
+		for _, name := range names {
+			if v, ok := args[name]; ok {
+				out[name] = v
+			}
+		}
+		return out
+	}
+}
+
+// confirmDestructive makes the tools in confirmations ask before they run,
+// unless cfg turns confirmation off. Clients that support elicitation are
+// asked to show the target to the user; others get a confirmation code to pass
+// back on a second call once the user agreed.
+func confirmDestructive(tools []models.Tool, cfg *config.APIConfig) []models.Tool {
+	if !cfg.ConfirmDestructive {
+		return tools
+	}
+	for i := range tools {
+		c, ok := confirmations[tools[i].Definition.Name]
+		if !ok {
+			continue
+		}
+		mcp.WithString("confirmationCode", mcp.Description("code from an earlier call that asked for confirmation; only pass it after the user agreed"))(&tools[i].Definition)
+		tools[i].Handler = confirmHandler(cfg, friendlyName(tools[i].Definition.Name), c, tools[i].Handler)
+	}
+	return tools
+}
+
+// confirmHandler runs next once the user confirmed. Codes are bound to the
+// stable tool name so that they also work through aliases and
+// invoke_jira_operation.
+func confirmHandler(cfg *config.APIConfig, name string, c confirmation, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	var lookup server.ToolHandlerFunc
+	if c.lookup != nil {
+		lookup = c.lookup(cfg)
+	}
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		logger := logging.FromContext(ctx)
+		args := request.GetArguments()
+		code, _ := args["confirmationCode"].(string)
+		callArgs := withoutKey(args, "confirmationCode")
+		key, err := cacheKey(cfg, name, callArgs)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to check confirmation", err), nil
+		}
+		if code != "" {
+			if !confirmationCodes.use(code, key) {
+				return mcp.NewToolResultError("The confirmation code is invalid, expired or was issued for other arguments. Call the tool again without confirmationCode."), nil
+			}
+			logger.Info("destructive call confirmed", "method", "code")
+			request.Params.Arguments = callArgs
+			return next(ctx, request)
+		}
+
+		target, err := describeTarget(ctx, c, lookup, callArgs)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("%s: cannot resolve the target, nothing was changed: %s", c.action, err)), nil
+		}
+		if client.IsDryRun(ctx) {
+			return next(ctx, request) // Nothing will change; the lookup above checked the target
+		}
+		message := fmt.Sprintf("%s %s?", c.action, target)
+		if !c.reversible {
+			message += " This cannot be undone."
+		}
+
+		if srv := server.ServerFromContext(ctx); srv != nil && canElicit(ctx) {
+			result, err := srv.RequestElicitation(ctx, confirmRequest(message))
+			if err == nil {
+				if !confirmed(result) {
+					logger.Info("destructive call declined", "action", string(result.Action))
+					return mcp.NewToolResultError("The user did not confirm. Nothing was changed: " + message), nil
+				}
+				logger.Info("destructive call confirmed", "method", "elicitation")
+				return next(ctx, request)
+			}
+			logger.Warn("confirmation request failed, falling back to a confirmation code", "error", err)
+		}
+
+		code = confirmationCodes.issue(key)
+		again := fmt.Sprintf("call %s again with the same arguments and \"confirmationCode\": %q", request.Params.Name, code)
+		if cfg.LazyTools {
+			// Only the meta-tools are registered, so the tool cannot be called by name
+			again = fmt.Sprintf("call invoke_jira_operation again with operationId %q and the same arguments, adding \"confirmationCode\": %q to them", request.Params.Name, code)
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("Confirmation required: %s\n\nNothing was changed. Show this to the user. Only if they agree, %s. The code expires in %s.",
+			message, again, confirmationCodeTTL)), nil
+	}
+}
+
+// describeTarget names the target of a call, e.g. `version "2.0" (id 10001)`,
+// followed by the other arguments, which change what happens to it.
+func describeTarget(ctx context.Context, c confirmation, lookup server.ToolHandlerFunc, args map[string]any) (string, error) {
+	var parts []string
+	rest := args
+	if lookup != nil {
+		lookupArgs := c.lookupArgs(args)
+		ctx = logging.WithToolName(ctx, c.lookupTool)
+		text, err := callTool(ctx, lookup, c.lookupTool, lookupArgs)
+		if err != nil {
+			return "", err
+		}
+		if label := targetLabel(text); label != "" {
+			parts = append(parts, label)
+			rest = map[string]any{}
+			for k, v := range args {
+				if _, ok := lookupArgs[k]; !ok {
+					rest[k] = v
+				}
+			}
+		}
+	}
+	if details := argumentList(rest); details != "" {
+		parts = append(parts, "with "+details)
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// targetLabel picks the identifying fields of a Jira resource.
+func targetLabel(text string) string {
+	var v struct {
+		ID          any    `json:"id"`
+		Key         string `json:"key"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+		BaseURL     string `json:"baseUrl"`
+		Project     string `json:"project"`
+		Fields      struct {
+			Summary string `json:"summary"`
+		} `json:"fields"`
+	}
+	if json.Unmarshal([]byte(text), &v) != nil {
+		return ""
+	}
+	var label []string
+	switch {
+	case v.Fields.Summary != "":
+		label = append(label, fmt.Sprintf("%s %q", v.Key, v.Fields.Summary))
+	case v.DisplayName != "":
+		label = append(label, fmt.Sprintf("%q (%s)", v.DisplayName, v.Name))
+	case v.Name != "" && v.Key != "":
+		label = append(label, fmt.Sprintf("%s %q", v.Key, v.Name))
+	case v.Name != "":
+		label = append(label, fmt.Sprintf("%q", v.Name))
+	case v.BaseURL != "":
+		label = append(label, "(currently "+v.BaseURL+")")
+	}
+	if v.ID != nil {
+		label = append(label, fmt.Sprintf("(id %v)", v.ID))
+	}
+	if v.Project != "" {
+		label = append(label, "in project "+v.Project)
+	}
+	return strings.Join(label, " ")
+}
+
+// argumentList formats the call arguments in a stable order.
+func argumentList(args map[string]any) string {
+	keys := make([]string, 0, len(args))
+	for k := range args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%v", k, args[k]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// canElicit reports whether the client of the current session declared the
+// elicitation capability.
+func canElicit(ctx context.Context) bool {
+	info, ok := server.ClientSessionFromContext(ctx).(server.SessionWithClientInfo)
+	return ok && info.GetClientCapabilities().Elicitation != nil
+}
+
+func confirmRequest(message string) mcp.ElicitationRequest {
+	var request mcp.ElicitationRequest
+	request.Params.Message = message
+	request.Params.RequestedSchema = map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"confirm": map[string]any{
+				"type":        "boolean",
+				"title":       "Confirm",
+				"description": "Check to go ahead.",
+				"default":     false,
+			},
+		},
+		"required": []string{"confirm"},
+	}
+	return request
+}
+
+// confirmed reports whether the user accepted and ticked confirm.
+func confirmed(result *mcp.ElicitationResult) bool {
+	if result == nil || result.Action != mcp.ElicitationResponseActionAccept {
+		return false
+	}
+	content, _ := result.Content.(map[string]any)
+	ok, _ := content["confirm"].(bool)
+	return ok
+}
+
+func withoutKey(args map[string]any, key string) map[string]any {
+	out := make(map[string]any, len(args))
+	for k, v := range args {
+		if k != key {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// codeStore holds single-use confirmation codes, each bound to the instance,
+// credentials, tool and arguments it was issued for. It is shared by all
+// servers because HTTP mode creates one per request.
+type codeStore struct {
+	mu    sync.Mutex
+	codes map[string]cacheEntry
+}
+
+var confirmationCodes = &codeStore{codes: map[string]cacheEntry{}}
+
+func (s *codeStore) issue(key string) string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	code := hex.EncodeToString(b)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for t, e := range s.codes {
+		if now.After(e.expires) {
+			delete(s.codes, t)
+		}
+	}
+	s.codes[code] = cacheEntry{text: key, expires: now.Add(confirmationCodeTTL)}
+	return code
+}
+
+// use consumes code if it is valid for key.
+func (s *codeStore) use(code, key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.codes[code]
+	if !ok || e.text != key || time.Now().After(e.expires) {
+		return false
+	}
+	delete(s.codes, code)
+	return true
+}
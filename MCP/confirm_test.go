@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestCodeStore(t *testing.T) {
+	store := &codeStore{codes: map[string]cacheEntry{}}
+
+	code := store.issue("key")
+	if store.use(code, "other key") {
+		t.Error("code was accepted for another key")
+	}
+	if !store.use(code, "key") {
+		t.Error("code was rejected for its key")
+	}
+	if store.use(code, "key") {
+		t.Error("code was accepted twice")
+	}
+	if store.use("unknown", "key") {
+		t.Error("unknown code was accepted")
+	}
+
+	code = store.issue("key")
+	store.codes[code] = cacheEntry{text: "key", expires: time.Now().Add(-time.Second)}
+	if store.use(code, "key") {
+		t.Error("expired code was accepted")
+	}
+}
+
+var confirmationCodeRE = regexp.MustCompile(`"confirmationCode": "([0-9a-f]+)"`)
+
+func TestConfirmHandler(t *testing.T) {
+	cfg := &config.APIConfig{BaseURL: "https://jira.example.com/rest"}
+	c := confirmation{
+		action:     "Delete version",
+		lookupTool: "jira_get_version",
+		lookupArgs: pass("id"),
+		lookup: func(*config.APIConfig) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return mcp.NewToolResultText(`{"id": "10001", "name": "2.0", "project": "ABC"}`), nil
+			}
+		},
+	}
+	var calls []map[string]any
+	next := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls = append(calls, request.GetArguments())
+		return mcp.NewToolResultText("deleted"), nil
+	}
+	handler := confirmHandler(cfg, "jira_delete_version", c, next)
+	call := func(args map[string]any) string {
+		var request mcp.CallToolRequest
+		request.Params.Name = "jira_delete_version"
+		request.Params.Arguments = args
+		result, err := handler(context.Background(), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result.Content[0].(mcp.TextContent).Text
+	}
+
+	text := call(map[string]any{"id": "10001", "moveFixIssuesTo": "10002"})
+	if want := `Delete version "2.0" (id 10001) in project ABC with moveFixIssuesTo=10002? This cannot be undone.`; !strings.Contains(text, want) {
+		t.Errorf("first call = %q, want it to contain %q", text, want)
+	}
+	if len(calls) != 0 {
+		t.Fatalf("first call ran the tool")
+	}
+	m := confirmationCodeRE.FindStringSubmatch(text)
+	if m == nil {
+		t.Fatalf("first call = %q, want a confirmation code", text)
+	}
+	code := m[1]
+
+	if text := call(map[string]any{"id": "10003", "moveFixIssuesTo": "10002", "confirmationCode": code}); !strings.Contains(text, "invalid, expired or was issued for other arguments") {
+		t.Errorf("call with other arguments = %q", text)
+	}
+	if text := call(map[string]any{"id": "10001", "moveFixIssuesTo": "10002", "confirmationCode": code}); text != "deleted" {
+		t.Errorf("confirmed call = %q, want the tool result", text)
+	}
+	if len(calls) != 1 {
+		t.Fatalf("tool ran %d times, want 1", len(calls))
+	}
+	if _, ok := calls[0]["confirmationCode"]; ok {
+		t.Errorf("tool got the confirmation code in %v", calls[0])
+	}
+	if text := call(map[string]any{"id": "10001", "moveFixIssuesTo": "10002", "confirmationCode": code}); !strings.Contains(text, "invalid, expired") {
+		t.Errorf("second use of the code = %q", text)
+	}
+	if len(calls) != 1 {
+		t.Errorf("tool ran %d times, want 1", len(calls))
+	}
+}
+
+func TestConfirmHandlerLazy(t *testing.T) {
+	cfg := &config.APIConfig{BaseURL: "https://jira.example.com/rest", LazyTools: true}
+	next := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("deleted"), nil
+	}
+	handler := confirmHandler(cfg, "jira_delete_component", confirmation{action: "Delete component"}, next)
+
+	var request mcp.CallToolRequest
+	request.Params.Name = "jira_delete_component"
+	request.Params.Arguments = map[string]any{"id": "10000"}
+	result, err := handler(context.Background(), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	if want := `call invoke_jira_operation again with operationId "jira_delete_component"`; !strings.Contains(text, want) {
+		t.Errorf("lazy-mode confirmation = %q, want it to contain %q", text, want)
+	}
+	if confirmationCodeRE.FindStringSubmatch(text) == nil {
+		t.Errorf("lazy-mode confirmation = %q, want a confirmation code", text)
+	}
+}
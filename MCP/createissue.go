@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// numericID matches ids as opposed to names, e.g. of an issue type.
+var numericID = regexp.MustCompile(`^[0-9]+$`)
+
+// createIssueTool gives jira_create_issue parameters for the common fields
+// and a map of other fields by display name. The generated tool sends no
+// request body, so its handler is replaced rather than wrapped.
+func createIssueTool(tool models.Tool, cfg *config.APIConfig) models.Tool {
+	tool.Definition = mcp.NewTool(tool.Definition.Name,
+		mcp.WithDescription("Creates an issue or a sub-task. Other fields are given by display name, e.g. {\"Story Points\": 3, \"Components\": \"API, UI\", \"Due Date\": \"2017-12-01\"}, and are checked against the create screen of the project and issue type before anything is sent: unknown fields and missing required fields are reported together. Full documentation: describe_jira_operation."),
+		mcp.WithString("projectKey", mcp.Required(), mcp.Description("key of the project, e.g. ABC")),
+		mcp.WithString("issueType", mcp.Required(), mcp.Description("issue type name or id, e.g. Bug")),
+		mcp.WithString("summary", mcp.Required(), mcp.Description("issue summary")),
+		mcp.WithString("description", mcp.Description("issue description, in Markdown unless markup is wiki")),
+		mcp.WithString("parent", mcp.Description("key of the parent issue, for sub-tasks")),
+		mcp.WithObject("fields", mcp.Description("other fields by display name or id. Values may be plain: a name for options, priorities, versions, components and users, a list or comma-separated string for multi-value fields, YYYY-MM-DD for dates; objects are sent unchanged")),
+		withMarkup(),
+	)
+	tool.Handler = createIssueHandler(cfg)
+	return tool
+}
+
+// createMeta is the part of /api/2/issue/createmeta used to create issues.
+type createMeta struct {
+	Projects []struct {
+		ID         string `json:"id"`
+		Key        string `json:"key"`
+		IssueTypes []struct {
+			ID      string               `json:"id"`
+			Name    string               `json:"name"`
+			Subtask bool                 `json:"subtask"`
+			Fields  map[string]metaField `json:"fields"`
+		} `json:"issuetypes"`
+	} `json:"projects"`
+}
+
+func createIssueHandler(cfg *config.APIConfig) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectKey, err := request.RequireString("projectKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		issueType, err := request.RequireString("issueType")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		summary, err := request.RequireString("summary")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		query := url.Values{"projectKeys": {projectKey}, "expand": {"projects.issuetypes.fields"}}
+		if numericID.MatchString(issueType) {
+			query.Set("issuetypeIds", issueType)
+		} else {
+			query.Set("issuetypeNames", issueType)
+		}
+		var meta createMeta
+		if err := getJSON(ctx, cfg, "/api/2/issue/createmeta?"+query.Encode(), &meta); err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to get create metadata", err), nil
+		}
+		if len(meta.Projects) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("Project %s does not exist or you cannot create issues in it.", projectKey)), nil
+		}
+		project := meta.Projects[0]
+		if len(project.IssueTypes) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("Issue type %q is not available in project %s. Available: %s.", issueType, project.Key, strings.Join(issueTypeNames(ctx, cfg, project.Key), ", "))), nil
+		}
+		it := project.IssueTypes[0]
+
+		fields := map[string]any{
+			"project":   map[string]any{"key": project.Key},
+			"issuetype": map[string]any{"id": it.ID},
+			"summary":   summary,
+		}
+		if description := request.GetString("description", ""); description != "" {
+			fields["description"] = description
+		}
+		if parent := request.GetString("parent", ""); parent != "" {
+			fields["parent"] = map[string]any{"key": parent}
+		}
+
+		resolver := newFieldResolver(ctx, cfg, it.Fields, fmt.Sprintf("create screen of %s in %s", it.Name, project.Key))
+		extra, _ := request.GetArguments()["fields"].(map[string]any)
+		resolved, problems := resolver.resolveAll(extra)
+		for id, value := range resolved {
+			fields[id] = value
+		}
+		richTextToWiki(request, fields, it.Fields)
+		if it.Subtask && fields["parent"] == nil {
+			problems = append(problems, fmt.Sprintf("%s is a sub-task type and needs a parent", it.Name))
+		}
+		problems = append(problems, resolver.missingRequired(fields)...)
+		if len(problems) > 0 {
+			return mcp.NewToolResultError("Nothing was created:\n- " + strings.Join(problems, "\n- ")), nil
+		}
+
+		data, err := jiraRequest(ctx, cfg, "POST", "/api/2/issue", map[string]any{"fields": fields})
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		var result map[string]any
+		if err := json.Unmarshal(data, &result); err != nil {
+			return mcp.NewToolResultText(string(data)), nil
+		}
+		logging.FromContext(ctx).Info("issue created", "issue", result["key"], "fields", len(fields))
+		pretty, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+		}
+		return mcp.NewToolResultText(string(pretty)), nil
+	}
+}
+
+// issueTypeNames lists the issue types that can be created in a project,
+// for error messages.
+func issueTypeNames(ctx context.Context, cfg *config.APIConfig, projectKey string) []string {
+	var meta createMeta
+	if err := getJSON(ctx, cfg, "/api/2/issue/createmeta?projectKeys="+url.QueryEscape(projectKey), &meta); err != nil || len(meta.Projects) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(meta.Projects[0].IssueTypes))
+	for _, it := range meta.Projects[0].IssueTypes {
+		names = append(names, it.Name)
+	}
+	return names
+}
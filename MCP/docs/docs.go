@@ -0,0 +1,12 @@
+// Package docs holds the full Markdown documentation of every Jira tool.
+// Tool descriptions only carry a one-line summary; clients fetch the rest on
+// demand through describe_jira_operation.
+package docs
+
+//go:generate go run ../cmd/gentools -spec "../../swagger (6).yaml" -tools ../tools -out docs_gen.go
+
+// Lookup returns the documentation for a tool by its friendly name.
+func Lookup(name string) (string, bool) {
+	doc, ok := operations[name]
+	return doc, ok
+}
@@ -0,0 +1,313 @@
+// Code generated by gentools. DO NOT EDIT.
+
+package docs
+
+var operations = map[string]string{
+	"jira_add_audit_record":                                "Store a record in Audit Log",
+	"jira_add_field_to_default_screen":                     "Adds field or custom field to the default tab",
+	"jira_add_filter_share_permission":                     "Adds a share permissions to the given filter. Adding a global permission removes all previous permissions from the filter.",
+	"jira_add_issue_attachment":                            "Add one or more attachments to an issue.\n\nThis resource expects a multipart post. The media-type multipart/form-data is defined in RFC 1867. Most client libraries have classes that make dealing with multipart posts simple. For instance, in Java the Apache HTTP Components library provides a [MultiPartEntity](http://hc.apache.org/httpcomponents-client-ga/httpmime/apidocs/org/apache/http/entity/mime/MultipartEntity.html) that makes it simple to submit a multipart POST.\n\nIn order to protect against XSRF attacks, because this method accepts multipart/form-data, it has XSRF protection on it. This means you must submit a header of X-Atlassian-Token: no-check with the request, otherwise it will be blocked.\n\nThe name of the multipart/form-data parameter that contains attachments must be \"file\"\n\nA simple example to upload a file called \"myfile.txt\" to issue REST-123:\n\n```\ncurl -D- -u admin:admin -X POST -H \"X-Atlassian-Token: no-check\" -F \"file=@myfile.txt\" http://myhost/rest/api/2/issue/TEST-123/attachments\n```",
+	"jira_add_issue_comment":                               "Adds a new comment to an issue.",
+	"jira_add_issue_vote":                                  "Cast your vote in favour of an issue.",
+	"jira_add_issue_watcher":                               "Adds a user to an issue's watcher list.",
+	"jira_add_issue_worklog":                               "Adds a new worklog entry to an issue.",
+	"jira_add_project_role_actors":                         "Adds an actor (user or group) to a project role.",
+	"jira_add_role_default_actors":                         "Adds default actors to the given role. The request data should contain a list of usernames or a list of groups to add.",
+	"jira_add_screen_tab":                                  "Creates tab for given screen",
+	"jira_add_screen_tab_field":                            "Adds field to the given tab.",
+	"jira_add_user_to_application":                         "Add user to given application. Admin permission will be required to perform this operation.",
+	"jira_add_user_to_group":                               "Adds given user to a group.\n\nReturns the current state of the group.",
+	"jira_assign_issue":                                    "Assigns an issue to a user. You can use this resource to assign issues when the user submitting the request has the assign permission but not the edit issue permission. If the name is \"-1\" automatic assignee is used. A null name will remove the assignee.",
+	"jira_assign_project_permission_scheme":                "Assigns a permission scheme with a project.",
+	"jira_change_my_password":                              "Modify caller password.",
+	"jira_change_user_password":                            "Modify user password.",
+	"jira_check_password_policy_for_new_user":              "Returns a list of statements explaining why the password policy would disallow a proposed password for a new user.\n\nYou can use this method to test the password policy validation. This could be done prior to an action where a new user and related password are created, using methods like the ones in [UserService](https://docs.atlassian.com/jira/latest/com/atlassian/jira/bc/user/UserService.html). For example, you could use this to validate a password in a create user form in the user interface, as the user enters it.\n\nThe username and new password must be not empty to perform the validation.\n\nNote, this method will help you validate against the policy only. It won't check any other validations that might be performed when creating a new user, e.g. checking whether a user with the same name already exists.",
+	"jira_check_password_policy_for_user_update":           "Returns a list of statements explaining why the password policy would disallow a proposed new password for a user with an existing password.\n\nYou can use this method to test the password policy validation. This could be done prior to an action where the password is actually updated, using methods like [ChangePassword](https://docs.atlassian.com/jira/latest/com/atlassian/jira/web/action/user/ChangePassword.html) or [ResetPassword](https://docs.atlassian.com/jira/latest/com/atlassian/jira/web/action/user/ResetPassword.html). For example, you could use this to validate a password in a change password form in the user interface, as the user enters it.\n\nThe user must exist and the username and new password must be not empty, to perform the validation.\n\nNote, this method will help you validate against the policy only. It won't check any other validations that might be performed when submitting a password change/reset request, e.g. verifying whether the old password is valid.",
+	"jira_create_component":                                "Create a component via POST.",
+	"jira_create_custom_field":                             "Creates a custom field using a definition (object encapsulating custom field data)",
+	"jira_create_filter":                                   "Creates a new filter, and returns newly created filter. Currently sets permissions just using the users default sharing permissions",
+	"jira_create_group":                                    "Creates a group by given group parameter\n\nReturns REST representation for the requested group.",
+	"jira_create_issue":                                    "Creates an issue or a sub-task from a JSON representation.\n\nThe fields that can be set on create, in either the fields parameter or the update parameter can be determined using the **/rest/api/2/issue/createmeta** resource. If a field is not configured to appear on the create screen, then it will not be in the createmeta, and a field validation error will occur if it is submitted.\n\nCreating a sub-task is similar to creating a regular issue, with two important differences:\n\n- the `issueType` field must correspond to a sub-task issue type (you can use `/issue/createmeta` to discover sub-task issue types), and\n- you must provide a `parent` field in the issue create request containing the id or key of the parent issue.",
+	"jira_create_issue_link_type":                          "Create a new issue link type.",
+	"jira_create_issue_type":                               "Creates an issue type from a JSON representation and adds the issue newly created issue type to the default issue type scheme.",
+	"jira_create_issues_bulk":                              "Creates issues or sub-tasks from a JSON representation.\n\nCreates many issues in one bulk operation.\n\nCreating a sub-task is similar to creating a regular issue. More details can be found in createIssue section: `createIssue(IssueUpdateBean)`}",
+	"jira_create_or_update_remote_issue_link":              "Creates or updates a remote issue link from a JSON representation. If a globalId is provided and a remote issue link exists with that globalId, the remote issue link is updated. Otherwise, the remote issue link is created.",
+	"jira_create_or_update_version_remote_link":            "Create a remote version link via POST. The link's global ID will be taken from the JSON payload if provided; otherwise, it will be generated.",
+	"jira_create_permission_scheme":                        "Create a new permission scheme. This method can create schemes with a defined permission set, or without.",
+	"jira_create_permission_scheme_grant":                  "Creates a permission grant in a permission scheme.",
+	"jira_create_project":                                  "Creates a new project.",
+	"jira_create_project_category":                         "Create a project category via POST.",
+	"jira_create_role":                                     "Creates a new ProjectRole to be available in JIRA. The created role does not have any default actors assigned.",
+	"jira_create_user":                                     "Create user. By default created user will not be notified with email. If password field is not set then password will be randomly generated.",
+	"jira_create_version":                                  "Create a version via POST.",
+	"jira_create_version_remote_link":                      "Create a remote version link via POST. The link's global ID will be taken from the JSON payload if provided; otherwise, it will be generated.",
+	"jira_create_workflow_scheme":                          "Create a new workflow scheme.\n\nThe body contains a representation of the new scheme. Values not passed are assumed to be set to their defaults.",
+	"jira_create_workflow_scheme_draft":                    "Create a draft for the passed scheme. The draft will be a copy of the state of the parent.",
+	"jira_create_workflow_transition_property":             "Add a new property to a transition. Trying to add a property that already exists will fail.",
+	"jira_crop_issue_type_avatar":                          "Converts temporary avatar into a real avatar",
+	"jira_crop_project_avatar":                             "Converts temporary avatar into a real avatar",
+	"jira_crop_temporary_avatar":                           "Updates the cropping instructions of the temporary avatar.",
+	"jira_crop_user_avatar":                                "Converts temporary avatar into a real avatar",
+	"jira_delete_attachment":                               "Remove an attachment from an issue.",
+	"jira_delete_comment_property":                         "Removes the property from the comment identified by the key or by the id. Ths user removing the property is required to have permissions to administer the comment.",
+	"jira_delete_component":                                "Delete a project component.",
+	"jira_delete_dashboard_item_property":                  "Removes the property from the dashboard item identified by the key or by the id. Ths user removing the property is required to have permissions to administer the dashboard item.",
+	"jira_delete_filter":                                   "Delete a filter.",
+	"jira_delete_filter_share_permission":                  "Removes a share permissions from the given filter.",
+	"jira_delete_group":                                    "Deletes a group by given group parameter.\n\nReturns no content",
+	"jira_delete_issue":                                    "Delete an issue.\n\nIf the issue has subtasks you must set the parameter deleteSubtasks=true to delete the issue. You cannot delete an issue without its subtasks also being deleted.",
+	"jira_delete_issue_comment":                            "Deletes an existing comment .",
+	"jira_delete_issue_link":                               "Deletes an issue link with the specified id. To be able to delete an issue link you must be able to view both issues and must have the link issue permission for at least one of the issues.",
+	"jira_delete_issue_link_type":                          "Delete the specified issue link type.",
+	"jira_delete_issue_property":                           "Removes the property from the issue identified by the key or by the id. Ths user removing the property is required to have permissions to edit the issue.",
+	"jira_delete_issue_type":                               "Deletes the specified issue type. If the issue type has any associated issues, these issues will be migrated to the alternative issue type specified in the parameter. You can determine the alternative issue types by calling the **/rest/api/2/issuetype/{id}/alternatives** resource.",
+	"jira_delete_issue_type_property":                      "Removes the property from the issue type identified by the id. Ths user removing the property is required to have permissions to edit the issue type.",
+	"jira_delete_issue_worklog":                            "Deletes an existing worklog entry.",
+	"jira_delete_my_preference":                            "Removes preference of the currently logged in user. Preference key must be provided as input parameters (key). If key parameter is not provided or wrong - status code 404. If preference is unset - status code 204.",
+	"jira_delete_permission_scheme":                        "Deletes a permission scheme identified by the given id.",
+	"jira_delete_permission_scheme_grant":                  "Deletes a permission grant from a permission scheme.",
+	"jira_delete_project":                                  "Deletes a project.",
+	"jira_delete_project_avatar":                           "Deletes avatar",
+	"jira_delete_project_category":                         "Delete a project category.",
+	"jira_delete_project_property":                         "Removes the property from the project identified by the key or by the id. Ths user removing the property is required to have permissions to administer the project.",
+	"jira_delete_project_role_actor":                       "Deletes actors (users or groups) from a project role.\n\n- Delete a user from the role: `/rest/api/2/project/{projectIdOrKey}/role/{roleId}?user={username}`\n- Delete a group from the role: `/rest/api/2/project/{projectIdOrKey}/role/{roleId}?group={groupname}`",
+	"jira_delete_project_role_default_actors":              "Removes default actor from the given role.",
+	"jira_delete_remote_issue_link":                        "Delete the remote issue link with the given id on the issue.",
+	"jira_delete_remote_issue_link_by_global_id":           "Delete the remote issue link with the given global id on the issue.",
+	"jira_delete_role":                                     "Deletes a role. May return 403 in the future",
+	"jira_delete_screen_tab":                               "Deletes tab to give screen",
+	"jira_delete_universal_avatar":                         "Deletes avatar",
+	"jira_delete_user":                                     "Removes user.",
+	"jira_delete_user_avatar":                              "Deletes avatar",
+	"jira_delete_user_property":                            "Removes the property from the user identified by the key or by the id. Ths user removing the property is required to have permissions to administer the user.",
+	"jira_delete_version":                                  "Delete a project version.",
+	"jira_delete_version_and_swap":                         "Delete a project version.",
+	"jira_delete_version_remote_link":                      "Delete a specific remote version link with the given version ID and global ID.",
+	"jira_delete_version_remote_links":                     "Delete all remote version links for a given version ID.",
+	"jira_delete_workflow_scheme":                          "Delete the passed workflow scheme.",
+	"jira_delete_workflow_scheme_default":                  "Remove the default workflow from the passed workflow scheme.",
+	"jira_delete_workflow_scheme_draft":                    "Delete the passed draft workflow scheme.",
+	"jira_delete_workflow_scheme_draft_default":            "Remove the default workflow from the passed draft workflow scheme.",
+	"jira_delete_workflow_scheme_draft_issue_type_mapping": "Remove the specified issue type mapping from the draft scheme.",
+	"jira_delete_workflow_scheme_draft_workflow_mapping":   "Delete the passed workflow from the draft workflow scheme.",
+	"jira_delete_workflow_scheme_issue_type_mapping":       "Remove the specified issue type mapping from the scheme.",
+	"jira_delete_workflow_scheme_workflow_mapping":         "Delete the passed workflow from the workflow scheme.",
+	"jira_delete_workflow_transition_property":             "Delete a property from the passed transition on the passed workflow. It is not an error to delete a property that does not exist.",
+	"jira_do_issue_transition":                             "Perform a transition on an issue. When performing the transition you can update or set other issue fields.\n\nThe fields that can be set on transtion, in either the fields parameter or the update parameter can be determined using the **/rest/api/2/issue/{issueIdOrKey}/transitions?expand=transitions.fields** resource. If a field is not configured to appear on the transition screen, then it will not be in the transition metadata, and a field validation error will occur if it is submitted.",
+	"jira_edit_issue":                                      "Edits an issue from a JSON representation.\n\nThe issue can either be updated by setting explicit the field value(s) or by using an operation to change the field value.\n\nThe fields that can be updated, in either the fields parameter or the update parameter, can be determined using the **/rest/api/2/issue/{issueIdOrKey}/editmeta** resource.\n\nIf a field is not configured to appear on the edit screen, then it will not be in the editmeta, and a field validation error will occur if it is submitted.\n\nSpecifying a \"field_id\": field_value in the \"fields\" is a shorthand for a \"set\" operation in the \"update\" section.\n\nField should appear either in \"fields\" or \"update\", not in both.",
+	"jira_expand_attachment_for_humans":                    "Tries to expand an attachment. Output is human-readable and subject to change.",
+	"jira_expand_attachment_raw":                           "Tries to expand an attachment. Output is raw and should be backwards-compatible through the course of time.",
+	"jira_find_assignable_users":                           "Returns a list of users that match the search string. This resource cannot be accessed anonymously. Please note that this resource should be called with an issue key when a list of assignable users is retrieved for editing. For create only a project key should be supplied. The list of assignable users may be incorrect if it's called with the project key for editing.",
+	"jira_find_bulk_assignable_users":                      "Returns a list of users that match the search string and can be assigned issues for all the given projects. This resource cannot be accessed anonymously.",
+	"jira_find_groups":                                     "Returns groups with substrings matching a given query. This is mainly for use with the group picker, so the returned groups contain html to be used as picker suggestions. The groups are also wrapped in a single response object that also contains a header for use in the picker, specifically *Showing X of Y matching groups*.\n\nThe number of groups returned is limited by the system property \"jira.ajax.autocomplete.limit\"\n\nThe groups will be unique and sorted.",
+	"jira_find_users":                                      "Returns a list of users that match the search string. This resource cannot be accessed anonymously.",
+	"jira_find_users_and_groups":                           "Returns a list of users and groups matching query with highlighting. This resource cannot be accessed anonymously.",
+	"jira_find_users_for_picker":                           "Returns a list of users matching query with highlighting. This resource cannot be accessed anonymously.",
+	"jira_find_users_with_all_permissions":                 "Returns a list of active users that match the search string and have all specified permissions for the project or issue.\n\nThis resource can be accessed by users with ADMINISTER_PROJECT permission for the project or global ADMIN or SYSADMIN rights.",
+	"jira_find_users_with_browse_permission":               "Returns a list of active users that match the search string. This resource cannot be accessed anonymously and requires the Browse Users global permission. Given an issue key this resource will provide a list of users that match the search string and have the browse issue permission for the issue provided.",
+	"jira_get_accessible_project_type":                     "Returns the project type with the given key, if it is accessible to the logged in user. This takes into account whether the user is licensed on the Application that defines the project type.",
+	"jira_get_advanced_settings":                           "Returns the properties that are displayed on the \"General Configuration > Advanced Settings\" page.",
+	"jira_get_application_property":                        "Returns an application property.",
+	"jira_get_application_role":                            "Returns the ApplicationRole with passed key if it exists.",
+	"jira_get_attachment":                                  "Returns the meta-data for an attachment, including the URI of the actual attached file.",
+	"jira_get_attachment_meta":                             "Returns the meta information for an attachments, specifically if they are enabled and the maximum upload size allowed.",
+	"jira_get_comment_property":                            "Returns the value of the property with a given key from the comment identified by the key or by the id. The user who retrieves the property is required to have permissions to read the comment.",
+	"jira_get_component":                                   "Returns a project component.",
+	"jira_get_component_related_issue_counts":              "Returns counts of issues related to this component.",
+	"jira_get_configuration":                               "Returns the information if the optional features in JIRA are enabled or disabled. If the time tracking is enabled, it also returns the detailed information about time tracking configuration.",
+	"jira_get_create_issue_meta":                           "Returns the meta data for creating issues. This includes the available projects, issue types and fields, including field types and whether or not those fields are required. Projects will not be returned if the user does not have permission to create issues in that project.\n\nThe fields in the createmeta correspond to the fields in the create screen for the project/issuetype. Fields not in the screen will not be in the createmeta.\n\nFields will only be returned if `expand=projects.issuetypes.fields`.\n\nThe results can be filtered by project and/or issue type, given by the query params.",
+	"jira_get_current_session":                             "Returns information about the currently authenticated user's session. If the caller is not authenticated they will get a 401 Unauthorized status code.",
+	"jira_get_custom_field_option":                         "Returns a full representation of the Custom Field Option that has the given id.",
+	"jira_get_dashboard":                                   "Returns a single dashboard.",
+	"jira_get_dashboard_item_property":                     "Returns the value of the property with a given key from the dashboard item identified by the id. The user who retrieves the property is required to have permissions to read the dashboard item.",
+	"jira_get_edit_issue_meta":                             "Returns the meta data for editing an issue.\n\nThe fields in the editmeta correspond to the fields in the edit screen for the issue. Fields not in the screen will not be in the editmeta.",
+	"jira_get_filter":                                      "Returns a filter given an id",
+	"jira_get_filter_columns":                              "Returns the default columns for the given filter. Currently logged in user will be used as the user making such request.",
+	"jira_get_filter_default_share_scope":                  "Returns the default share scope of the logged-in user.",
+	"jira_get_filter_share_permission":                     "Returns a single share permission of the given filter.",
+	"jira_get_group":                                       "Returns REST representation for the requested group. Allows to get list of active users belonging to the specified group and its subgroups if \"users\" expand option is provided. You can page through users list by using indexes in expand param. For example to get users from index 10 to index 15 use \"users[10:15]\" expand value. This will return 6 users (if there are at least 16 users in this group). Indexes are 0-based and inclusive.\n\nThis resource is deprecated, please use group/member API instead.",
+	"jira_get_index_summary":                               "Summarizes index condition of current node.\n\nReturned data consists of:\n\n- `nodeId` - Node identifier.\n- `reportTime` - Time of this report creation.\n- `issueIndex` - Summary of issue index status.\n- `replicationQueues` - Map of index replication queues, where keys represent nodes from which replication operations came from.\n\n`issueIndex` can contain:\n\n- `indexReadable` - If `false` the end point failed to read data from issue index (check JIRA logs for detailed stack trace), otherwise `true`. When `false` other fields of `issueIndex` can be not visible.\n- `countInDatabase` - Count of issues found in database.\n- `countInIndex` - Count of issues found while querying index.\n- `lastUpdatedInDatabase` - Time of last update of issue found in database.\n- `lastUpdatedInIndex` - Time of last update of issue found while querying index.\n\n`replicationQueues`'s map values can contain:\n\n- `lastConsumedOperation` - Last executed index replication operation by current node from sending node's queue.\n- `lastConsumedOperation.id` - Identifier of the operation.\n- `lastConsumedOperation.replicationTime` - Time when the operation was sent to other nodes.\n- `lastOperationInQueue` - Last index replication operation in sending node's queue.\n- `lastOperationInQueue.id` - Identifier of the operation.\n- `lastOperationInQueue.replicationTime` - Time when the operation was sent to other nodes.\n- `queueSize` - Number of operations in queue from sending node to current node.",
+	"jira_get_issue":                                       "Returns a full representation of the issue for the given issue key.\n\nAn issue JSON consists of the issue key, a collection of fields, a link to the workflow transition sub-resource, and (optionally) the HTML rendered values of any fields that support it (e.g. if wiki syntax is enabled for the description or comments).\n\nThe `fields` param (which can be specified multiple times) gives a comma-separated list of fields to include in the response. This can be used to retrieve a subset of fields. A particular field can be excluded by prefixing it with a minus.\n\nBy default, all (`*all`) fields are returned in this get-issue resource. Note: the default is different when doing a jql search -- the default there is just navigable fields (`*navigable`).\n\n- `*all` - include all fields\n- `*navigable` - include just navigable fields\n- `summary,comment` - include just the summary and comments\n- `-comment` - include everything except comments (the default is `*all` for get-issue)\n- `*all,-comment` - include everything except comments\n\nThe `properties` param is similar to `fields` and specifies a comma-separated list of issue properties to include. Unlike `fields`, properties are not included by default. To include them all send `?properties=*all`. You can also include only specified properties or exclude some properties with a minus (-) sign.\n\n- `*all` - include all properties\n- `*all, -prop1` - include all properties except `prop1`\n- `prop1, prop1` - include `prop1` and `prop2` properties\n\nJIRA will attempt to identify the issue by the `issueIdOrKey` path parameter. This can be an issue id, or an issue key. If the issue cannot be found via an exact match, JIRA will also look for the issue in a case-insensitive way, or by looking to see if the issue was moved. In either of these cases, the request will proceed as normal (a 302 or other redirect will **not** be returned). The issue key contained in the response will indicate the current value of issue's key.\n\nThe `expand` param is used to include, hidden by default, parts of response. This can be used to include:\n\n- `renderedFields` - field values in HTML format\n- `names` - display name of each field\n- `schema` - schema for each field which describes a type of the field\n- `transitions` - all possible transitions for the given issue\n- `operations` - all possibles operations which may be applied on issue\n- `editmeta` - information about how each field may be edited. It contains field's schema as well.\n- `changelog` - history of all changes of the given issue\n- `versionedRepresentations` - REST representations of all fields. Some field may contain more recent versions. RESET representations are numbered. The greatest number always represents the most recent version. It is recommended that the most recent version is used. version for these fields which provide a more recent REST representation. After including `versionedRepresentations` \"fields\" field become hidden.",
+	"jira_get_issue_comment":                               "Returns a single comment.",
+	"jira_get_issue_link":                                  "Returns an issue link with the specified id.",
+	"jira_get_issue_link_type":                             "Returns for a given issue link type id all information about this issue link type.",
+	"jira_get_issue_navigator_default_columns":             "Returns the default system columns for issue navigator. Admin permission will be required.",
+	"jira_get_issue_property":                              "Returns the value of the property with a given key from the issue identified by the key or by the id. The user who retrieves the property is required to have permissions to read the issue.",
+	"jira_get_issue_security_level":                        "Returns a full representation of the security level that has the given id.",
+	"jira_get_issue_security_scheme":                       "Returns the issue security scheme along with that are defined.",
+	"jira_get_issue_type":                                  "Returns a full representation of the issue type that has the given id.",
+	"jira_get_issue_type_property":                         "Returns the value of the property with a given key from the issue type identified by the id. The user who retrieves the property is required to have permissions to view the issue type.",
+	"jira_get_issue_votes":                                 "A REST sub-resource representing the voters on the issue.",
+	"jira_get_issue_worklog":                               "Returns a specific worklog.\n\n**Note:** The work log won't be returned if the Log work field is hidden for the project.",
+	"jira_get_jql_autocomplete_data":                       "Returns the auto complete data required for JQL searches.",
+	"jira_get_jql_autocomplete_suggestions":                "Returns auto complete suggestions for JQL search.",
+	"jira_get_my_permissions":                              "Returns all permissions in the system and whether the currently logged in user has them. You can optionally provide a specific context to get permissions for (projectKey OR projectId OR issueKey OR issueId)\n\n- When no context supplied the project related permissions will return true if the user has that permission in ANY project\n- If a project context is provided, project related permissions will return true if the user has the permissions in the specified project. For permissions that are determined using issue data (e.g Current Assignee), true will be returned if the user meets the permission criteria in ANY issue in that project\n- If an issue context is provided, it will return whether or not the user has each permission in that specific issue\n\nNB: The above means that for issue-level permissions (EDIT_ISSUE for example), hasPermission may be true when no context is provided, or when a project context is provided, **but** may be false for any given (or all) issues. This would occur (for example) if Reporters were given the EDIT_ISSUE permission. This is because any user could be a reporter, except in the context of a concrete issue, where the reporter is known.\n\nGlobal permissions will still be returned for all scopes.\n\nPrior to version 6.4 this service returned project permissions with keys corresponding to com.atlassian.jira.security.Permissions.Permission constants. Since 6.4 those keys are considered deprecated and this service returns system project permission keys corresponding to constants defined in com.atlassian.jira.permission.ProjectPermissions. Permissions with legacy keys are still also returned for backwards compatibility, they are marked with an attribute deprecatedKey=true. The attribute is missing for project permissions with the current keys.",
+	"jira_get_my_preference":                               "Returns preference of the currently logged in user. Preference key must be provided as input parameter (key). The value is returned exactly as it is. If key parameter is not provided or wrong - status code 404. If value is found - status code 200.",
+	"jira_get_myself":                                      "Returns currently logged user. This resource cannot be accessed anonymously.",
+	"jira_get_notification_scheme":                         "Returns a full representation of the notification scheme for the given id. This resource will return a notification scheme containing a list of events and recipient configured to receive notifications for these events. Consumer should allow events without recipients to appear in response. User accessing the data is required to have permissions to administer at least one project associated with the requested notification scheme.\n\nNotification recipients can be:\n\n- current assignee - the value of the notificationType is CurrentAssignee\n- issue reporter - the value of the notificationType is Reporter\n- current user - the value of the notificationType is CurrentUser\n- project lead - the value of the notificationType is ProjectLead\n- component lead - the value of the notificationType is ComponentLead\n- all watchers - the value of the notification type is AllWatchers\n- configured user - the value of the notification type is User. Parameter will contain key of the user. Information about the user will be provided if **user** expand parameter is used.\n- configured group - the value of the notification type is Group. Parameter will contain name of the group. Information about the group will be provided if **group** expand parameter is used.\n- configured email address - the value of the notification type is EmailAddress, additionally information about the email will be provided.\n- users or users in groups in the configured custom fields - the value of the notification type is UserCustomField or GroupCustomField. Parameter will contain id of the custom field. Information about the field will be provided if **field** expand parameter is used.\n- configured project role - the value of the notification type is ProjectRole. Parameter will contain project role id. Information about the project role will be provided if **projectRole** expand parameter is used.\n\nPlease see the example for reference.\n\nThe events can be JIRA system events or events configured by administrator. In case of the system events, data about theirs ids, names and descriptions is provided. In case of custom events, the template event is included as well.",
+	"jira_get_password_policy":                             "Returns the list of requirements for the current password policy. For example, \"The password must have at least 10 characters.\", \"The password must not be similar to the user's name or email address.\", etc.",
+	"jira_get_permission_scheme":                           "Returns a permission scheme identified by the given id.",
+	"jira_get_permission_scheme_grant":                     "Returns a permission grant identified by the given id.",
+	"jira_get_priority":                                    "Returns an issue priority.",
+	"jira_get_project":                                     "Contains a full representation of a project in JSON format.\n\nAll project keys associated with the project will only be returned if `expand=projectKeys`.",
+	"jira_get_project_category":                            "Contains a representation of a project category in JSON format.",
+	"jira_get_project_issue_security_scheme":               "Returns the issue security scheme for project.",
+	"jira_get_project_notification_scheme":                 "Gets a notification scheme associated with the project. Follow the documentation of /notificationscheme/{id} resource for all details about returned value.",
+	"jira_get_project_permission_scheme":                   "Gets a permission scheme assigned with a project.",
+	"jira_get_project_property":                            "Returns the value of the property with a given key from the project identified by the key or by the id. The user who retrieves the property is required to have permissions to read the project.",
+	"jira_get_project_role":                                "Returns the details for a given project role in a project.",
+	"jira_get_project_type":                                "Returns the project type with the given key.",
+	"jira_get_reindex_info":                                "Returns information on the system reindexes. If a reindex is currently taking place then information about this reindex is returned. If there is no active index task, then returns information about the latest reindex task run, otherwise returns a 404 indicating that no reindex has taken place.",
+	"jira_get_reindex_progress":                            "Returns information on the system reindexes. If a reindex is currently taking place then information about this reindex is returned. If there is no active index task, then returns information about the latest reindex task run, otherwise returns a 404 indicating that no reindex has taken place.",
+	"jira_get_reindex_request_progress":                    "Retrieves the progress of a single reindex request.",
+	"jira_get_reindex_requests_progress":                   "Retrieves the progress of a multiple reindex requests. Only reindex requests that actually exist will be returned in the results.",
+	"jira_get_remote_issue_link":                           "Get the remote issue link with the given id on the issue.",
+	"jira_get_resolution":                                  "Returns a resolution.",
+	"jira_get_role":                                        "Get a specific ProjectRole available in JIRA.",
+	"jira_get_server_info":                                 "Returns general information about the current JIRA server.",
+	"jira_get_status":                                      "Returns a full representation of the Status having the given id or name.",
+	"jira_get_status_category":                             "Returns a full representation of the StatusCategory having the given id or key",
+	"jira_get_upgrade_result":                              "Returns the result of the last upgrade task.\n\nReturns `URI)` if still running.",
+	"jira_get_user":                                        "Returns a user. This resource cannot be accessed anonymously.",
+	"jira_get_user_columns":                                "Returns the default columns for the given user. Admin permission will be required to get columns for a user other than the currently logged in user.",
+	"jira_get_user_property":                               "Returns the value of the property with a given key from the user identified by the key or by the id. The user who retrieves the property is required to have permissions to read the user.",
+	"jira_get_version":                                     "Returns a project version.",
+	"jira_get_version_related_issue_counts":                "Returns a bean containing the number of fixed in and affected issues for the given version.",
+	"jira_get_version_remote_link":                         "A REST sub-resource representing a remote version link",
+	"jira_get_version_unresolved_issue_count":              "Returns the number of unresolved issues for the given version",
+	"jira_get_workflow_scheme":                             "Returns the requested workflow scheme to the caller.",
+	"jira_get_workflow_scheme_default":                     "Return the default workflow from the passed workflow scheme.",
+	"jira_get_workflow_scheme_draft":                       "Returns the requested draft workflow scheme to the caller.",
+	"jira_get_workflow_scheme_draft_default":               "Return the default workflow from the passed draft workflow scheme to the caller.",
+	"jira_get_workflow_scheme_draft_issue_type_mapping":    "Returns the issue type mapping for the passed draft workflow scheme.",
+	"jira_get_workflow_scheme_draft_workflow_mapping":      "Returns the draft workflow mappings or requested mapping to the caller.",
+	"jira_get_workflow_scheme_issue_type_mapping":          "Returns the issue type mapping for the passed workflow scheme.",
+	"jira_get_workflow_scheme_workflow_mapping":            "Returns the workflow mappings or requested mapping to the caller for the passed scheme.",
+	"jira_get_workflow_transition_properties":              "Return the property or properties associated with a transition.",
+	"jira_issue_picker":                                    "Returns suggested issues which match the auto-completion query for the user which executes this request. This REST method will check the user's history and the user's browsing context and select this issues, which match the query.",
+	"jira_link_issues":                                     "Creates an issue link between two issues. The user requires the link issue permission for the issue which will be linked to another issue. The specified link type in the request is used to create the link and will create a link from the first issue to the second issue using the outward description. It also create a link from the second issue to the first issue using the inward description of the issue link type. It will add the supplied comment to the first issue. The comment can have a restriction who can view it. If group is specified, only users of this group can view this comment, if roleLevel is specified only users who have the specified role can view this comment. The user who creates the issue link needs to belong to the specified group or have the specified role.",
+	"jira_list_application_roles":                          "Returns all ApplicationRoles in the system. Will also return an ETag header containing a version hash of the collection of ApplicationRoles.",
+	"jira_list_audit_records":                              "Returns auditing records filtered using provided parameters",
+	"jira_list_comment_property_keys":                      "Returns the keys of all properties for the comment identified by the key or by the id.",
+	"jira_list_dashboard_item_property_keys":               "Returns the keys of all properties for the dashboard item identified by the id.",
+	"jira_list_dashboards":                                 "Returns a list of all dashboards, optionally filtering them.",
+	"jira_list_deleted_worklog_ids":                        "Returns worklogs id and delete time of worklogs that was deleted since given time. The returns set of worklogs is limited to 1000 elements. This API will not return worklogs deleted during last minute.",
+	"jira_list_favourite_filters":                          "Returns the favourite filters of the logged-in user.",
+	"jira_list_fields":                                     "Returns a list of all fields, both System and Custom",
+	"jira_list_filter_share_permissions":                   "Returns all share permissions of the given filter.",
+	"jira_list_group_members":                              "This resource returns a [paginated](#pagination) list of users who are members of the specified group and its subgroups. Users in the page are ordered by user names. User of this resource is required to have sysadmin or admin permissions.",
+	"jira_list_issue_comments":                             "Returns all comments for an issue.\n\nResults can be ordered by the \"created\" field which means the date a comment was added.",
+	"jira_list_issue_link_types":                           "Returns a list of available issue link types, if issue linking is enabled. Each issue link type has an id, a name and a label for the outward and inward link relationship.",
+	"jira_list_issue_property_keys":                        "Returns the keys of all properties for the issue identified by the key or by the id.",
+	"jira_list_issue_security_schemes":                     "Returns all issue security schemes that are defined.",
+	"jira_list_issue_transitions":                          "Get a list of the transitions possible for this issue by the current user, along with fields that are required and their types.\n\nFields will only be returned if `expand=transitions.fields`.\n\nThe fields in the metadata correspond to the fields in the transition screen for that transition. Fields not in the screen will not be in the metadata.",
+	"jira_list_issue_type_alternatives":                    "Returns a list of all alternative issue types for the given issue type id. The list will contain these issues types, to which issues assigned to the given issue type can be migrated. The suitable alternatives are issue types which are assigned to the same workflow, the same field configuration and the same screen scheme.",
+	"jira_list_issue_type_property_keys":                   "Returns the keys of all properties for the issue type identified by the id.",
+	"jira_list_issue_types":                                "Returns a list of all issue types visible to the user",
+	"jira_list_issue_watchers":                             "Returns the list of watchers for the issue with the given key.",
+	"jira_list_issue_worklogs":                             "Returns all work logs for an issue.\n\n**Note:** Work logs won't be returned if the Log work field is hidden for the project.",
+	"jira_list_notification_schemes":                       "Returns a [paginated](#pagination) list of notification schemes. In order to access notification scheme, the calling user is required to have permissions to administer at least one project associated with the requested notification scheme. Each scheme contains a list of events and recipient configured to receive notifications for these events. Consumer should allow events without recipients to appear in response. The list is ordered by the scheme's name. Follow the documentation of /notificationscheme/{id} resource for all details about returned value.",
+	"jira_list_permission_scheme_grants":                   "Returns all permission grants of the given permission scheme.",
+	"jira_list_permission_schemes":                         "Returns a list of all permission schemes.\n\nBy default only shortened beans are returned. If you want to include permissions of all the schemes, then specify the **permissions** expand parameter. Permissions will be included also if you specify any other expand parameter.",
+	"jira_list_permissions":                                "Returns all permissions that are present in the JIRA instance - Global, Project and the global ones added by plugins",
+	"jira_list_priorities":                                 "Returns a list of all issue priorities.",
+	"jira_list_project_avatars":                            "Returns all avatars which are visible for the currently logged in user. The avatars are grouped into system and custom.",
+	"jira_list_project_categories":                         "Returns all project categories",
+	"jira_list_project_components":                         "Contains a full representation of a the specified project's components.",
+	"jira_list_project_property_keys":                      "Returns the keys of all properties for the project identified by the key or by the id.",
+	"jira_list_project_roles":                              "Returns all roles in the given project Id or key, with links to full details on each role.",
+	"jira_list_project_security_levels":                    "Returns all security levels for the project that the current logged in user has access to. If the user does not have the Set Issue Security permission, the list will be empty.",
+	"jira_list_project_statuses":                           "Get all issue types with valid status values for a project",
+	"jira_list_project_types":                              "Returns all the project types defined on the JIRA instance, not taking into account whether the license to use those project types is valid or not.",
+	"jira_list_project_versions":                           "Contains a full representation of a the specified project's versions.",
+	"jira_list_project_versions_paginated":                 "Returns all versions for the specified project. Results are [paginated](#pagination).\n\nResults can be ordered by the following fields:\n\n- sequence\n- name\n- startDate\n- releaseDate",
+	"jira_list_projects":                                   "Returns all projects which are visible for the currently logged in user. If no user is logged in, it returns the list of projects that are visible when using anonymous access.",
+	"jira_list_remote_issue_links":                         "A REST sub-resource representing the remote issue links on the issue.",
+	"jira_list_resolutions":                                "Returns a list of all resolutions.",
+	"jira_list_role_default_actors":                        "Gets default actors for the given role.",
+	"jira_list_roles":                                      "Get all the ProjectRoles available in JIRA. Currently this list is global.",
+	"jira_list_screen_available_fields":                    "Gets available fields for screen. i.e ones that haven't already been added.",
+	"jira_list_screen_tab_fields":                          "Gets all fields for a given tab",
+	"jira_list_screen_tabs":                                "Returns a list of all tabs for the given screen",
+	"jira_list_status_categories":                          "Returns a list of all status categories",
+	"jira_list_statuses":                                   "Returns a list of all statuses",
+	"jira_list_subtasks":                                   "Returns an issue's subtask list",
+	"jira_list_system_avatars":                             "Returns all system avatars of the given type.",
+	"jira_list_updated_worklog_ids":                        "Returns worklogs id and update time of worklogs that was updated since given time. The returns set of worklogs is limited to 1000 elements. This API will not return worklogs updated during last minute.",
+	"jira_list_user_avatars":                               "Returns all avatars which are visible for the currently logged in user.",
+	"jira_list_user_property_keys":                         "Returns the keys of all properties for the user identified by the key or by the id.",
+	"jira_list_version_remote_links":                       "Returns the remote version links associated with the given version ID.",
+	"jira_list_version_remote_links_by_global_id":          "Returns the remote version links for a given global ID.",
+	"jira_list_workflows":                                  "Returns all workflows.",
+	"jira_list_worklogs_by_ids":                            "Returns worklogs for given worklog ids. Only worklogs to which the calling user has permissions, will be included in the result. The returns set of worklogs is limited to 1000 elements.",
+	"jira_login":                                           "Creates a new session for a user in JIRA. Once a session has been successfully created it can be used to access any of JIRA's remote APIs and also the web UI by passing the appropriate HTTP Cookie header.\n\nNote that it is generally preferrable to use HTTP BASIC authentication with the REST API. However, this resource may be used to mimic the behaviour of JIRA's log-in page (e.g. to display log-in errors to a user).",
+	"jira_logout":                                          "Logs the current user out of JIRA, destroying the existing session, if any.",
+	"jira_merge_version":                                   "Merge versions",
+	"jira_move_screen_tab":                                 "Moves tab position",
+	"jira_move_screen_tab_field":                           "Moves field on the given tab",
+	"jira_move_subtask":                                    "Reorders an issue's subtasks by moving the subtask at index \"from\" to index \"to\".",
+	"jira_move_version":                                    "Modify a version's sequence within a project.\n\nThe move version bean has 2 alternative field value pairs:\n\n- position\n- An absolute position, which may have a value of 'First', 'Last', 'Earlier' or 'Later'\n- after\n- A version to place this version after. The value should be the self link of another version",
+	"jira_notify_issue":                                    "Sends a notification (email) to the list or recipients defined in the request.",
+	"jira_partially_update_role":                           "Partially updates a roles name or description.",
+	"jira_process_reindex_requests":                        "Executes any pending reindex requests. Returns a JSON array containing the IDs of the reindex requests that are being processed. Execution is asynchronous - progress of the returned tasks can be monitored through other REST calls.",
+	"jira_reindex_issues":                                  "Reindexes one or more individual issues. Indexing is performed synchronously - the call returns when indexing of the issues has completed or a failure occurs.\n\nUse either explicitly specified issue IDs or a JQL query to select issues to reindex.",
+	"jira_release_websudo":                                 "This method invalidates the any current WebSudo session.",
+	"jira_remove_issue_vote":                               "Remove your vote from an issue. (i.e. \"unvote\")",
+	"jira_remove_issue_watcher":                            "Removes a user from an issue's watcher list.",
+	"jira_remove_screen_tab_field":                         "Removes field from given tab",
+	"jira_remove_user_from_application":                    "Remove user from given application. Admin permission will be required to perform this operation.",
+	"jira_remove_user_from_group":                          "Removes given user from a group.\n\nReturns no content",
+	"jira_rename_screen_tab":                               "Renames tab on given screen",
+	"jira_reset_filter_columns":                            "Resets the columns for the given filter such that the filter no longer has its own column config.",
+	"jira_reset_user_columns":                              "Reset the default columns for the given user to the system default. Admin permission will be required to get columns for a user other than the currently logged in user.",
+	"jira_run_upgrades_now":                                "Runs any pending delayed upgrade tasks. Need Admin permissions to do this.",
+	"jira_search_issues":                                   "Searches for issues using JQL.\n\n**Sorting** the `jql` parameter is a full [JQL](http://confluence.atlassian.com/display/JIRA/Advanced+Searching) expression, and includes an `ORDER BY` clause.\n\nThe `fields` param (which can be specified multiple times) gives a comma-separated list of fields to include in the response. This can be used to retrieve a subset of fields. A particular field can be excluded by prefixing it with a minus.\n\nBy default, only navigable (`*navigable`) fields are returned in this search resource. Note: the default is different in the get-issue resource -- the default there all fields (`*all`).\n\n- `*all` - include all fields\n- `*navigable` - include just navigable fields\n- `summary,comment` - include just the summary and comments\n- `-description` - include navigable fields except the description (the default is `*navigable` for search)\n- `*all,-comment` - include everything except comments\n\n**GET vs POST:** If the JQL query is too large to be encoded as a query param you should instead POST to this resource.\n\n**Expanding Issues in the Search Result:** It is possible to expand the issues returned by directly specifying the expansion on the expand parameter passed in to this resources.\n\nFor instance, to expand the \"changelog\" for all the issues on the search result, it is neccesary to specify \"changelog\" as one of the values to expand.",
+	"jira_search_issues_post":                              "Performs a search using JQL.",
+	"jira_set_application_property":                        "Modify an application property via PUT. The \"value\" field present in the PUT will override the existing value.",
+	"jira_set_base_url":                                    "Sets the base URL that is configured for this JIRA instance.",
+	"jira_set_comment_property":                            "Sets the value of the specified comment's property.\n\nYou can use this resource to store a custom data against the comment identified by the key or by the id. The user who stores the data is required to have permissions to administer the comment.",
+	"jira_set_dashboard_item_property":                     "Sets the value of the specified dashboard item's property.\n\nYou can use this resource to store a custom data against the dashboard item identified by the id. The user who stores the data is required to have permissions to administer the dashboard item.",
+	"jira_set_filter_columns":                              "Sets the default columns for the given filter.",
+	"jira_set_filter_default_share_scope":                  "Sets the default share scope of the logged-in user. Available values are GLOBAL and PRIVATE.",
+	"jira_set_issue_navigator_default_columns":             "Sets the default system columns for issue navigator. Admin permission will be required.",
+	"jira_set_issue_property":                              "Sets the value of the specified issue's property.\n\nYou can use this resource to store a custom data against the issue identified by the key or by the id. The user who stores the data is required to have permissions to edit the issue.",
+	"jira_set_issue_type_property":                         "Sets the value of the specified issue type's property.\n\nYou can use this resource to store a custom data against an issue type identified by the id. The user who stores the data is required to have permissions to edit an issue type.",
+	"jira_set_my_preference":                               "Sets preference of the currently logged in user. Preference key must be provided as input parameters (key). Value must be provided as post body. If key or value parameter is not provided - status code 404. If preference is set - status code 204.",
+	"jira_set_permission_scheme_attribute":                 "Updates or inserts the attribute for a permission scheme specified by permission scheme id. The attribute consists of the key and the value. The value will be converted to Boolean using Boolean#valueOf.",
+	"jira_set_project_property":                            "Sets the value of the specified project's property.\n\nYou can use this resource to store a custom data against the project identified by the key or by the id. The user who stores the data is required to have permissions to administer the project.",
+	"jira_set_project_role_actors":                         "Updates a project role to include the specified actors (users or groups).",
+	"jira_set_user_columns":                                "Sets the default columns for the given user. Admin permission will be required to get columns for a user other than the currently logged in user.",
+	"jira_set_user_property":                               "Sets the value of the specified user's property.\n\nYou can use this resource to store a custom data against the user identified by the key or by the id. The user who stores the data is required to have permissions to administer the user.",
+	"jira_set_workflow_scheme_draft_issue_type_mapping":    "Set the issue type mapping for the passed draft scheme.\n\nThe passed representation can have its updateDraftIfNeeded flag set to true to indicate that the draft should be created/updated when the actual scheme cannot be edited.",
+	"jira_set_workflow_scheme_issue_type_mapping":          "Set the issue type mapping for the passed scheme.\n\nThe passed representation can have its updateDraftIfNeeded flag set to true to indicate that the draft should be created/updated when the actual scheme cannot be edited.",
+	"jira_start_reindex":                                   "Kicks off a reindex. Need Admin permissions to perform this reindex.",
+	"jira_store_issue_type_temporary_avatar":               "Creates temporary avatar using multipart. The response is sent back as JSON stored in a textarea. This is because the client uses remote iframing to submit avatars using multipart. So we must send them a valid HTML page back from which the client parses the JSON from.\n\nCreating a temporary avatar is part of a 3-step process in uploading a new avatar for an issue type: upload, crop, confirm. This endpoint allows you to use a multipart upload instead of sending the image directly as the request body.\n\nYou *must* use \"avatar\" as the name of the upload parameter:\n\n```\ncurl -c cookiejar.txt -X POST -u admin:admin -H \"X-Atlassian-Token: no-check\" \\\n   -F \"avatar=@mynewavatar.png;type=image/png\" \\\n   'http://localhost:8090/jira/rest/api/2/issuetype/1/avatar/temporary'\n```",
+	"jira_store_project_temporary_avatar":                  "Creates temporary avatar using multipart. The response is sent back as JSON stored in a textarea. This is because the client uses remote iframing to submit avatars using multipart. So we must send them a valid HTML page back from which the client parses the JSON.",
+	"jira_store_temporary_avatar":                          "Creates temporary avatar",
+	"jira_store_user_temporary_avatar":                     "Creates temporary avatar using multipart. The response is sent back as JSON stored in a textarea. This is because the client uses remote iframing to submit avatars using multipart. So we must send them a valid HTML page back from which the client parses the JSON from.\n\nCreating a temporary avatar is part of a 3-step process in uploading a new avatar for a user: upload, crop, confirm. This endpoint allows you to use a multipart upload instead of sending the image directly as the request body.\n\nYou *must* use \"avatar\" as the name of the upload parameter:\n\n```\ncurl -c cookiejar.txt -X POST -u admin:admin -H \"X-Atlassian-Token: no-check\" \\\n   -F \"avatar=@mynewavatar.png;type=image/png\" \\\n   'http://localhost:8090/jira/rest/api/2/user/avatar/temporary?username=admin'\n```",
+	"jira_update_application_role":                         "Updates the ApplicationRole with the passed data. Only the groups and default groups setting of the role may be updated. Requests to change the key or the name of the role will be silently ignored.\n\nOptional: If versionHash is passed through the If-Match header the request will be rejected if not the same as server",
+	"jira_update_application_roles":                        "Updates the ApplicationRoles with the passed data if the version hash is the same as the server. Only the groups and default groups setting of the role may be updated. Requests to change the key or the name of the role will be silently ignored. It is acceptable to pass only the roles that are updated as roles that are present in the server but not in data to update with, will not be deleted.",
+	"jira_update_component":                                "Modify a component via PUT. Any fields present in the PUT will override existing values. As a convenience, if a field is not present, it is silently ignored.\n\nIf leadUserName is an empty string (\"\") the component lead will be removed.",
+	"jira_update_filter":                                   "Updates an existing filter, and returns its new value.",
+	"jira_update_issue_comment":                            "Updates an existing comment using its JSON representation.",
+	"jira_update_issue_link_type":                          "Update the specified issue link type.",
+	"jira_update_issue_type":                               "Updates the specified issue type from a JSON representation.",
+	"jira_update_issue_worklog":                            "Updates an existing worklog entry.\n\nNote that:\n\n- Fields possible for editing are: comment, visibility, started, timeSpent and timeSpentSeconds.\n- Either timeSpent or timeSpentSeconds can be set.\n- Fields which are not set will not be updated.\n- For a request to be valid, it has to have at least one field change.",
+	"jira_update_myself":                                   "Modify currently logged user. The \"value\" fields present will override the existing value. Fields skipped in request will not be changed. Only email and display name can be change that way. Requires user password.",
+	"jira_update_permission_scheme":                        "Updates a permission scheme.\n\nIf the permissions list is present then it will be set in the permission scheme, which basically means it will overwrite any permission grants that existed in the permission scheme. Sending an empty list will remove all permission grants from the permission scheme.\n\nTo update just the name and description, do not send permissions list at all.\n\nTo add or remove a single permission grant instead of updating the whole list at once use the **{schemeId}/permission/** resource.",
+	"jira_update_project":                                  "Updates a project.\n\nOnly non null values sent in JSON will be updated in the project.\n\nValues available for the assigneeType field are: \"PROJECT_LEAD\" and \"UNASSIGNED\".",
+	"jira_update_project_category":                         "Modify a project category via PUT. Any fields present in the PUT will override existing values. As a convenience, if a field is not present, it is silently ignored.",
+	"jira_update_project_type":                             "Updates the type of a project.",
+	"jira_update_remote_issue_link":                        "Updates a remote issue link from a JSON representation. Any fields not provided are set to null.",
+	"jira_update_role":                                     "Fully updates a roles. Both name and description must be given.",
+	"jira_update_user":                                     "Modify user. The \"value\" fields present will override the existing value. Fields skipped in request will not be changed.",
+	"jira_update_version":                                  "Modify a version via PUT. Any fields present in the PUT will override existing values. As a convenience, if a field is not present, it is silently ignored.",
+	"jira_update_workflow_scheme":                          "Update the passed workflow scheme.\n\nThe body of the request is a representation of the workflow scheme. Values not passed are assumed to indicate no change for that field.\n\nThe passed representation can have its updateDraftIfNeeded flag set to true to indicate that the draft should be created and/or updated when the actual scheme cannot be edited (e.g. when the scheme is being used by a project). Values not appearing the body will not be touched.",
+	"jira_update_workflow_scheme_default":                  "Set the default workflow for the passed workflow scheme.\n\nThe passed representation can have its updateDraftIfNeeded flag set to true to indicate that the draft should be created/updated when the actual scheme cannot be edited.",
+	"jira_update_workflow_scheme_draft":                    "Update a draft workflow scheme. The draft will created if necessary.\n\nThe body is a representation of the workflow scheme. Values not passed are assumed to indicate no change for that field.",
+	"jira_update_workflow_scheme_draft_default":            "Set the default workflow for the passed draft workflow scheme.",
+	"jira_update_workflow_scheme_draft_workflow_mapping":   "Update the draft scheme to include the passed mapping.\n\nThe body is a representation of the workflow mapping. Values not passed are assumed to indicate no change for that field.",
+	"jira_update_workflow_scheme_workflow_mapping":         "Update the scheme to include the passed mapping.\n\nThe body is a representation of the workflow mapping. Values not passed are assumed to indicate no change for that field.\n\nThe passed representation can have its updateDraftIfNeeded flag set to true to indicate that the draft should be created/updated when the actual scheme cannot be edited.",
+	"jira_update_workflow_transition_property":             "Update/add new property to a transition. Trying to update a property that does not exist will result in a new property being added.",
+	"jira_validate_project_key":                            "Validates a project key.",
+}
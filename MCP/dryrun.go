@@ -0,0 +1,331 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jira-7-6-1/mcp-server/client"
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// dryRunTools adds a dryRun parameter to every tool that writes to Jira.
+// A dry run calls the tool with write requests recorded instead of sent and
+// returns them, checked against Jira where it offers a way to. With
+// cfg.DryRun every call is a dry run.
+func dryRunTools(tools []models.Tool, cfg *config.APIConfig) []models.Tool {
+	for i := range tools {
+		name := tools[i].Definition.Name
+		if client.IsSafeMethod(toolMethod(name)) || hintOverrides[name].readOnly {
+			continue
+		}
+		mcp.WithBoolean("dryRun", mcp.Description("return the request this call would send, validated against Jira where possible, without changing anything"))(&tools[i].Definition)
+		tools[i].Handler = dryRunHandler(cfg, friendlyName(name), tools[i].Handler)
+	}
+	return tools
+}
+
+// dryRunResult is what a dry run returns instead of the tool output.
+type dryRunResult struct {
+	DryRun     bool                     `json:"dryRun"`
+	Tool       string                   `json:"tool"`
+	Requests   []client.RecordedRequest `json:"requests"`
+	Validation dryRunValidation         `json:"validation"`
+	Result     json.RawMessage          `json:"result,omitempty"` // JSON output of the tool, such as a plan
+}
+
+type dryRunValidation struct {
+	OK       bool     `json:"ok"`
+	Checked  []string `json:"checked,omitempty"`  // What the requests were checked against
+	Problems []string `json:"problems,omitempty"` // Why Jira would reject them
+}
+
+func dryRunHandler(cfg *config.APIConfig, name string, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := request.GetArguments()
+		perCall, _ := args["dryRun"].(bool)
+		if !perCall && !cfg.DryRun {
+			return next(ctx, request)
+		}
+		request.Params.Arguments = withoutKey(args, "dryRun")
+
+		rec := &client.Recorder{}
+		result, err := next(client.WithDryRun(ctx, rec), request)
+		if err != nil {
+			return nil, err
+		}
+		requests := rec.Requests()
+		if result.IsError && len(requests) == 0 {
+			// Failed before building a request, e.g. on a missing argument.
+			return result, nil
+		}
+
+		out := dryRunResult{DryRun: true, Tool: name, Requests: requests}
+		if out.Requests == nil {
+			out.Requests = []client.RecordedRequest{}
+		}
+		out.Result = plannedResult(result)
+		out.Validation = validateRequests(ctx, cfg, requests)
+		logging.FromContext(ctx).Info("dry run", "requests", len(requests), "valid", out.Validation.OK)
+
+		text, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+		}
+		return mcp.NewToolResultText(string(text)), nil
+	}
+}
+
+// plannedResult returns the output of a tool in a dry run when it is more
+// than the stand-in response, e.g. the hops a multi-hop transition plans.
+func plannedResult(result *mcp.CallToolResult) json.RawMessage {
+	if result == nil || len(result.Content) == 0 {
+		return nil
+	}
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok || !json.Valid([]byte(text.Text)) {
+		return nil
+	}
+	var stub map[string]any
+	if json.Unmarshal([]byte(text.Text), &stub) == nil && (len(stub) == 0 || stub["dryRun"] == true) {
+		return nil
+	}
+	return json.RawMessage(text.Text)
+}
+
+// requestCheck validates recorded requests of one kind against Jira.
+type requestCheck struct {
+	method  string
+	path    *regexp.Regexp // Matched against the path after /api/2
+	against string         // What the check asks Jira, for the result
+	check   func(ctx context.Context, cfg *config.APIConfig, match []string, body map[string]any) ([]string, error)
+}
+
+var requestChecks = []requestCheck{
+	{method: "POST", path: regexp.MustCompile(`^/issue$`), against: "createmeta", check: checkCreateRequest},
+	{method: "POST", path: regexp.MustCompile(`^/issue/bulk$`), against: "createmeta", check: checkBulkCreateRequest},
+	{method: "PUT", path: regexp.MustCompile(`^/issue/([^/]+)$`), against: "editmeta", check: checkEditRequest},
+	{method: "POST", path: regexp.MustCompile(`^/issue/([^/]+)/transitions$`), against: "transitions", check: checkTransitionRequest},
+	{method: "POST", path: regexp.MustCompile(`^/user$`), against: "password policy", check: checkCreateUserRequest},
+}
+
+// validateRequests runs the matching checks on each recorded request. The
+// checks send read-only requests, so they run outside the dry run.
+func validateRequests(ctx context.Context, cfg *config.APIConfig, requests []client.RecordedRequest) dryRunValidation {
+	v := dryRunValidation{OK: true}
+	for _, r := range requests {
+		u, err := url.Parse(r.URL)
+		if err != nil {
+			continue
+		}
+		_, path, ok := strings.Cut(u.Path, "/api/2")
+		if !ok {
+			continue
+		}
+		for _, c := range requestChecks {
+			match := c.path.FindStringSubmatch(path)
+			if c.method != r.Method || match == nil {
+				continue
+			}
+			var body map[string]any
+			if raw := r.RawBody(); len(raw) > 0 {
+				if err := json.Unmarshal(raw, &body); err != nil {
+					v.Problems = append(v.Problems, fmt.Sprintf("%s /api/2%s: body is not a JSON object", r.Method, path))
+					continue
+				}
+			}
+			if body == nil {
+				continue // Nothing to check
+			}
+			problems, err := c.check(ctx, cfg, match, body)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("could not check against %s: %s", c.against, err))
+			}
+			v.Checked = appendUnique(v.Checked, c.against)
+			for _, p := range problems {
+				v.Problems = append(v.Problems, fmt.Sprintf("%s /api/2%s: %s", r.Method, path, p))
+			}
+		}
+	}
+	v.OK = len(v.Problems) == 0
+	return v
+}
+
+func checkCreateRequest(ctx context.Context, cfg *config.APIConfig, _ []string, body map[string]any) ([]string, error) {
+	fields, _ := body["fields"].(map[string]any)
+	return checkCreateFields(ctx, cfg, fields)
+}
+
+func checkBulkCreateRequest(ctx context.Context, cfg *config.APIConfig, _ []string, body map[string]any) ([]string, error) {
+	updates, _ := body["issueUpdates"].([]any)
+	var problems []string
+	for i, u := range updates {
+		update, _ := u.(map[string]any)
+		fields, _ := update["fields"].(map[string]any)
+		p, err := checkCreateFields(ctx, cfg, fields)
+		if err != nil {
+			return problems, err
+		}
+		for _, problem := range p {
+			problems = append(problems, fmt.Sprintf("issue %d: %s", i, problem))
+		}
+	}
+	return problems, nil
+}
+
+// checkCreateFields checks the fields of a new issue against the create
+// metadata of its project and issue type.
+func checkCreateFields(ctx context.Context, cfg *config.APIConfig, fields map[string]any) ([]string, error) {
+	query := url.Values{"expand": {"projects.issuetypes.fields"}}
+	project, _ := fields["project"].(map[string]any)
+	switch {
+	case project["key"] != nil:
+		query.Set("projectKeys", fmt.Sprint(project["key"]))
+	case project["id"] != nil:
+		query.Set("projectIds", fmt.Sprint(project["id"]))
+	default:
+		return []string{"fields.project needs a key or id"}, nil
+	}
+	issueType, _ := fields["issuetype"].(map[string]any)
+	switch {
+	case issueType["id"] != nil:
+		query.Set("issuetypeIds", fmt.Sprint(issueType["id"]))
+	case issueType["name"] != nil:
+		query.Set("issuetypeNames", fmt.Sprint(issueType["name"]))
+	default:
+		return []string{"fields.issuetype needs an id or name"}, nil
+	}
+
+	var meta struct {
+		Projects []struct {
+			Key        string `json:"key"`
+			IssueTypes []struct {
+				Name   string               `json:"name"`
+				Fields map[string]metaField `json:"fields"`
+			} `json:"issuetypes"`
+		} `json:"projects"`
+	}
+	if err := getJSON(ctx, cfg, "/api/2/issue/createmeta?"+query.Encode(), &meta); err != nil {
+		return nil, err
+	}
+	if len(meta.Projects) == 0 {
+		return []string{"the project does not exist or you cannot create issues in it"}, nil
+	}
+	if len(meta.Projects[0].IssueTypes) == 0 {
+		return []string{fmt.Sprintf("the issue type is not available in project %s", meta.Projects[0].Key)}, nil
+	}
+	allowed := meta.Projects[0].IssueTypes[0].Fields
+	return fieldProblems(fields, allowed, "is not on the create screen"), nil
+}
+
+func checkEditRequest(ctx context.Context, cfg *config.APIConfig, match []string, body map[string]any) ([]string, error) {
+	var meta struct {
+		Fields map[string]metaField `json:"fields"`
+	}
+	if err := getJSON(ctx, cfg, "/api/2/issue/"+match[1]+"/editmeta", &meta); err != nil {
+		return nil, err
+	}
+	var problems []string
+	for _, part := range []string{"fields", "update"} {
+		values, _ := body[part].(map[string]any)
+		for _, id := range sortedKeys(values) {
+			if _, ok := meta.Fields[id]; !ok {
+				problems = append(problems, fmt.Sprintf("field %q cannot be edited", id))
+			}
+		}
+	}
+	return problems, nil
+}
+
+func checkTransitionRequest(ctx context.Context, cfg *config.APIConfig, match []string, body map[string]any) ([]string, error) {
+	var result struct {
+		Transitions []struct {
+			ID     string               `json:"id"`
+			Name   string               `json:"name"`
+			Fields map[string]metaField `json:"fields"`
+		} `json:"transitions"`
+	}
+	if err := getJSON(ctx, cfg, "/api/2/issue/"+match[1]+"/transitions?expand=transitions.fields", &result); err != nil {
+		return nil, err
+	}
+	transition, _ := body["transition"].(map[string]any)
+	id := fmt.Sprint(transition["id"])
+	var available []string
+	for _, t := range result.Transitions {
+		if t.ID == id {
+			fields, _ := body["fields"].(map[string]any)
+			return fieldProblems(fields, t.Fields, "is not on the transition screen"), nil
+		}
+		available = append(available, fmt.Sprintf("%s (%s)", t.ID, t.Name))
+	}
+	return []string{fmt.Sprintf("transition %s is not available; available: %s", id, strings.Join(available, ", "))}, nil
+}
+
+func checkCreateUserRequest(ctx context.Context, cfg *config.APIConfig, _ []string, body map[string]any) ([]string, error) {
+	if body["password"] == nil {
+		return nil, nil // Jira generates a password
+	}
+	check := map[string]any{
+		"username":     body["name"],
+		"displayName":  body["displayName"],
+		"emailAddress": body["emailAddress"],
+		"password":     body["password"],
+	}
+	data, err := jiraRequest(ctx, cfg, "POST", "/api/2/password/policy/createUser", check)
+	if err != nil {
+		return nil, err
+	}
+	var messages []any
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return nil, fmt.Errorf("decode password policy result: %w", err)
+	}
+	problems := make([]string, 0, len(messages))
+	for _, m := range messages {
+		problems = append(problems, "password policy: "+fmt.Sprint(m))
+	}
+	return problems, nil
+}
+
+// fieldProblems lists required fields missing from fields and fields that
+// allowed does not contain.
+func fieldProblems(fields map[string]any, allowed map[string]metaField, notAllowed string) []string {
+	var problems []string
+	for _, id := range sortedKeys(allowed) {
+		f := allowed[id]
+		if _, ok := fields[id]; !ok && f.Required && !f.HasDefaultValue {
+			problems = append(problems, fmt.Sprintf("required field %q (%s) is missing", f.Name, id))
+		}
+	}
+	for _, id := range sortedKeys(fields) {
+		if _, ok := allowed[id]; !ok {
+			problems = append(problems, fmt.Sprintf("field %q %s", id, notAllowed))
+		}
+	}
+	return problems
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, item := range list {
+		if item == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
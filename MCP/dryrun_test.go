@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/jira-7-6-1/mcp-server/client"
+	"github.com/jira-7-6-1/mcp-server/config"
+)
+
+// fakeJira serves the metadata that dry runs are validated against.
+func fakeJira(t *testing.T) *httptest.Server {
+	t.Helper()
+	responses := map[string]string{
+		"GET /rest/api/2/issue/ABC-1/editmeta": `{"fields": {"summary": {"name": "Summary"}, "labels": {"name": "Labels"}}}`,
+		"GET /rest/api/2/issue/createmeta": `{"projects": [{"key": "ABC", "issuetypes": [{"name": "Bug", "fields": {
+			"project": {"name": "Project", "required": true},
+			"issuetype": {"name": "Issue Type", "required": true},
+			"summary": {"name": "Summary", "required": true},
+			"priority": {"name": "Priority", "required": true, "hasDefaultValue": true}}}]}]}`,
+		"GET /rest/api/2/issue/ABC-1/transitions":     `{"transitions": [{"id": "31", "name": "Done", "fields": {"resolution": {"name": "Resolution", "required": true}}}]}`,
+		"POST /rest/api/2/password/policy/createUser": `["The password must have at least 8 characters."]`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestValidateRequests(t *testing.T) {
+	srv := fakeJira(t)
+	cfg := &config.APIConfig{BaseURL: srv.URL + "/rest"}
+
+	tests := []struct {
+		name         string
+		method, path string
+		body         string
+		want         dryRunValidation
+	}{
+		{
+			name: "valid edit", method: "PUT", path: "/api/2/issue/ABC-1",
+			body: `{"fields": {"summary": "New"}, "update": {"labels": [{"add": "x"}]}}`,
+			want: dryRunValidation{OK: true, Checked: []string{"editmeta"}},
+		},
+		{
+			name: "field not on the edit screen", method: "PUT", path: "/api/2/issue/ABC-1",
+			body: `{"fields": {"summary": "New", "reporter": {"name": "bob"}}}`,
+			want: dryRunValidation{Checked: []string{"editmeta"}, Problems: []string{`PUT /api/2/issue/ABC-1: field "reporter" cannot be edited`}},
+		},
+		{
+			name: "valid create", method: "POST", path: "/api/2/issue",
+			body: `{"fields": {"project": {"key": "ABC"}, "issuetype": {"name": "Bug"}, "summary": "Broken"}}`,
+			want: dryRunValidation{OK: true, Checked: []string{"createmeta"}},
+		},
+		{
+			name: "create missing summary", method: "POST", path: "/api/2/issue",
+			body: `{"fields": {"project": {"key": "ABC"}, "issuetype": {"name": "Bug"}, "duedate": "2017-01-01"}}`,
+			want: dryRunValidation{Checked: []string{"createmeta"}, Problems: []string{
+				`POST /api/2/issue: required field "Summary" (summary) is missing`,
+				`POST /api/2/issue: field "duedate" is not on the create screen`,
+			}},
+		},
+		{
+			name: "unavailable transition", method: "POST", path: "/api/2/issue/ABC-1/transitions",
+			body: `{"transition": {"id": "11"}}`,
+			want: dryRunValidation{Checked: []string{"transitions"}, Problems: []string{`POST /api/2/issue/ABC-1/transitions: transition 11 is not available; available: 31 (Done)`}},
+		},
+		{
+			name: "transition missing a field", method: "POST", path: "/api/2/issue/ABC-1/transitions",
+			body: `{"transition": {"id": "31"}}`,
+			want: dryRunValidation{Checked: []string{"transitions"}, Problems: []string{`POST /api/2/issue/ABC-1/transitions: required field "Resolution" (resolution) is missing`}},
+		},
+		{
+			name: "weak password", method: "POST", path: "/api/2/user",
+			body: `{"name": "bob", "password": "short"}`,
+			want: dryRunValidation{Checked: []string{"password policy"}, Problems: []string{`POST /api/2/user: password policy: The password must have at least 8 characters.`}},
+		},
+		{
+			name: "unchecked request", method: "DELETE", path: "/api/2/issue/ABC-1",
+			want: dryRunValidation{OK: true},
+		},
+	}
+	for _, tt := range tests {
+		rec := &client.Recorder{}
+		var body any
+		if tt.body != "" {
+			if err := json.Unmarshal([]byte(tt.body), &body); err != nil {
+				t.Fatalf("%s: %v", tt.name, err)
+			}
+		}
+		if _, err := jiraRequest(client.WithDryRun(context.Background(), rec), cfg, tt.method, tt.path, body); err != nil {
+			t.Fatalf("%s: recording the request: %v", tt.name, err)
+		}
+
+		got := validateRequests(context.Background(), cfg, rec.Requests())
+		if got.OK != tt.want.OK || !slices.Equal(got.Checked, tt.want.Checked) || !slices.Equal(got.Problems, tt.want.Problems) {
+			t.Errorf("%s: validateRequests = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/jira-7-6-1/mcp-server/client"
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// editIssueTool gives jira_edit_issue the fields and update objects of the
+// edit request. The generated tool sends no request body, so its handler is
+// replaced rather than wrapped. Rich text in the objects is converted from
+// Markdown like in the other tools that write issues; the rest is sent as it
+// is.
+func editIssueTool(tool models.Tool, cfg *config.APIConfig) models.Tool {
+	tool.Definition = mcp.NewTool(tool.Definition.Name,
+		mcp.WithDescription("Edits an issue from a JSON representation: fields to set by field id, and update operations such as {\"labels\": [{\"add\": \"x\"}]}. Text of the description, environment, multi-line custom fields and comments is converted from Markdown; other values are sent as they are. Full documentation: describe_jira_operation."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("key or id of the issue, e.g. ABC-123")),
+		mcp.WithObject("fields", mcp.Description("field values to set, by field id, e.g. {\"summary\": \"New summary\"}")),
+		mcp.WithObject("update", mcp.Description("operations by field id, e.g. {\"components\": [{\"set\": [{\"name\": \"API\"}]}]}")),
+		mcp.WithBoolean("notifyUsers", mcp.Description("email the watchers about the change (default true); turning it off needs admin or project admin permission")),
+		withMarkup(),
+	)
+	tool.Handler = editIssueHandler(cfg)
+	return tool
+}
+
+func editIssueHandler(cfg *config.APIConfig) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		key, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		args := request.GetArguments()
+		body := map[string]any{}
+		for _, part := range []string{"fields", "update"} {
+			if value, ok := args[part].(map[string]any); ok && len(value) > 0 {
+				body[part] = value
+			}
+		}
+		if len(body) == 0 {
+			return mcp.NewToolResultError("Nothing was changed: give fields or update."), nil
+		}
+		if request.GetString("markup", "markdown") != "wiki" {
+			editToWiki(request, richTextFields(ctx, cfg), body)
+		}
+		path := "/api/2/issue/" + url.PathEscape(key)
+		if notify, ok := args["notifyUsers"]; ok {
+			path += "?notifyUsers=" + url.QueryEscape(fmt.Sprint(notify))
+		}
+
+		if _, err := jiraRequest(ctx, cfg, "PUT", path, body); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if client.IsDryRun(ctx) {
+			return mcp.NewToolResultText("{}"), nil // The dry-run result replaces this
+		}
+		logging.FromContext(ctx).Info("issue edited", "issue", key)
+		return mcp.NewToolResultText(fmt.Sprintf("Issue %s was updated.", key)), nil
+	}
+}
+
+// editToWiki converts the rich text of an edit request body: string values
+// of rich text fields, whether set under fields or by an update operation,
+// and the body of comments added or edited under update.
+func editToWiki(request mcp.CallToolRequest, rich richText, body map[string]any) {
+	fields, _ := body["fields"].(map[string]any)
+	for id, value := range fields {
+		if s, ok := value.(string); ok && rich[id] {
+			fields[id] = toWiki(request, s)
+		}
+	}
+	update, _ := body["update"].(map[string]any)
+	for id, ops := range update {
+		list, _ := ops.([]any)
+		for _, op := range list {
+			op, _ := op.(map[string]any)
+			for verb, value := range op {
+				switch value := value.(type) {
+				case string:
+					if rich[id] {
+						op[verb] = toWiki(request, value)
+					}
+				case map[string]any:
+					if text, ok := value["body"].(string); ok && id == "comment" {
+						value["body"] = toWiki(request, text)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestEditToWiki(t *testing.T) {
+	rich := richText{"description": true, "customfield_10300": true}
+	tests := []struct {
+		markup     string
+		body, want string
+	}{
+		{
+			body: `{"fields": {"description": "**Steps**", "summary": "**not rich**", "customfield_10300": "_notes_"}}`,
+			want: `{"fields":{"customfield_10300":"_notes_","description":"*Steps*","summary":"**not rich**"}}`,
+		},
+		{
+			body: `{"update": {"description": [{"set": "**x**"}], "labels": [{"add": "**y**"}], "comment": [{"add": {"body": "**z**"}}]}}`,
+			want: `{"update":{"comment":[{"add":{"body":"*z*"}}],"description":[{"set":"*x*"}],"labels":[{"add":"**y**"}]}}`,
+		},
+		{
+			markup: "wiki",
+			body:   `{"fields": {"description": "**kept**"}}`,
+			want:   `{"fields":{"description":"**kept**"}}`,
+		},
+	}
+	for _, tt := range tests {
+		var request mcp.CallToolRequest
+		request.Params.Arguments = map[string]any{"markup": tt.markup}
+		var body map[string]any
+		if err := json.Unmarshal([]byte(tt.body), &body); err != nil {
+			t.Fatal(err)
+		}
+		editToWiki(request, rich, body)
+		if got, _ := json.Marshal(body); string(got) != tt.want {
+			t.Errorf("editToWiki(%s) = %s, want %s", tt.body, got, tt.want)
+		}
+	}
+}
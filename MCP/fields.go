@@ -0,0 +1,261 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+)
+
+// metaField is a field description in createmeta, editmeta and transitions.
+type metaField struct {
+	Required        bool             `json:"required"`
+	Name            string           `json:"name"`
+	HasDefaultValue bool             `json:"hasDefaultValue"`
+	Schema          fieldSchema      `json:"schema"`
+	Operations      []string         `json:"operations"`
+	AllowedValues   []map[string]any `json:"allowedValues"`
+}
+
+// fieldSchema is the type of a field value, e.g. {"type": "array",
+// "items": "version", "system": "fixVersions"}.
+type fieldSchema struct {
+	Type   string `json:"type"`
+	Items  string `json:"items"`
+	System string `json:"system"`
+	Custom string `json:"custom"`
+}
+
+// fieldResolver turns field names as people write them, such as
+// "Story Points" or "fix versions", into field ids and values in the shape
+// Jira expects for the screen described by meta.
+type fieldResolver struct {
+	ctx    context.Context
+	cfg    *config.APIConfig
+	meta   map[string]metaField
+	screen string // Where the fields are shown, for messages, e.g. "create screen of Bug in ABC"
+
+	once   sync.Once
+	fields []jiraField // All fields, fetched when a name is not on the screen
+}
+
+func newFieldResolver(ctx context.Context, cfg *config.APIConfig, meta map[string]metaField, screen string) *fieldResolver {
+	return &fieldResolver{ctx: ctx, cfg: cfg, meta: meta, screen: screen}
+}
+
+// resolve returns the id of the screen field called name. name may be a
+// field id, such as customfield_10105, or a display name in any case.
+func (r *fieldResolver) resolve(name string) (string, error) {
+	matches := r.matches(name)
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		return "", r.notOnScreen(name)
+	default:
+		return "", fmt.Errorf("field %q is ambiguous, use one of the ids %s", name, strings.Join(matches, ", "))
+	}
+}
+
+// onScreen reports whether name refers to a field of the screen.
+func (r *fieldResolver) onScreen(name string) bool {
+	return len(r.matches(name)) > 0
+}
+
+func (r *fieldResolver) matches(name string) []string {
+	if _, ok := r.meta[name]; ok {
+		return []string{name}
+	}
+	var matches []string
+	for _, id := range sortedKeys(r.meta) {
+		if strings.EqualFold(r.meta[id].Name, name) || strings.EqualFold(id, name) {
+			matches = append(matches, id)
+		}
+	}
+	return matches
+}
+
+// notOnScreen explains why name did not resolve: it is either a field that
+// the screen does not show or no field at all.
+func (r *fieldResolver) notOnScreen(name string) error {
+	r.once.Do(func() {
+		if err := getJSON(r.ctx, r.cfg, "/api/2/field", &r.fields); err != nil {
+			r.fields = nil
+		}
+	})
+	for _, f := range r.fields {
+		if f.ID == name || strings.EqualFold(f.Name, name) {
+			return fmt.Errorf("field %q (%s) is not on the %s", f.Name, f.ID, r.screen)
+		}
+	}
+	return fmt.Errorf("unknown field %q", name)
+}
+
+// resolveAll resolves the names and coerces the values of input. It returns
+// every problem rather than stopping at the first one.
+func (r *fieldResolver) resolveAll(input map[string]any) (map[string]any, []string) {
+	out := make(map[string]any, len(input))
+	var problems []string
+	for _, name := range sortedKeys(input) {
+		id, err := r.resolve(name)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		value, err := coerceField(r.meta[id], input[name])
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("field %q: %s", r.meta[id].Name, err))
+			continue
+		}
+		out[id] = value
+	}
+	return out, problems
+}
+
+// missingRequired lists the required fields of the screen that have no
+// value in fields and no default.
+func (r *fieldResolver) missingRequired(fields map[string]any) []string {
+	var problems []string
+	for _, id := range sortedKeys(r.meta) {
+		f := r.meta[id]
+		if _, ok := fields[id]; ok || !f.Required || f.HasDefaultValue {
+			continue
+		}
+		problem := fmt.Sprintf("required field %q (%s) is missing", f.Name, id)
+		if allowed := allowedNames(f); len(allowed) > 0 {
+			problem += "; allowed values: " + strings.Join(allowed, ", ")
+		}
+		problems = append(problems, problem)
+	}
+	return problems
+}
+
+// coerceField converts a value written the simple way, e.g. "High" for a
+// priority or "1.0, 1.1" for fix versions, into the shape of field f.
+// Objects are passed through as they are assumed to be in Jira's shape.
+func coerceField(f metaField, value any) (any, error) {
+	if _, ok := value.(map[string]any); ok || value == nil {
+		return value, nil
+	}
+	if f.Schema.Type != "array" {
+		return coerceValue(f, f.Schema.Type, value)
+	}
+	var items []any
+	switch v := value.(type) {
+	case []any:
+		items = v
+	case []string:
+		for _, s := range v {
+			items = append(items, s)
+		}
+	case string:
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				items = append(items, s)
+			}
+		}
+	default:
+		items = []any{v}
+	}
+	out := make([]any, 0, len(items))
+	for _, item := range items {
+		if _, ok := item.(map[string]any); ok {
+			out = append(out, item)
+			continue
+		}
+		coerced, err := coerceValue(f, f.Schema.Items, item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, coerced)
+	}
+	return out, nil
+}
+
+// jiraDateTime is the layout Jira expects for datetime fields.
+const jiraDateTime = "2006-01-02T15:04:05.000-0700"
+
+// dateLayouts are the date and time formats accepted for date fields.
+var dateLayouts = []string{
+	time.RFC3339,
+	jiraDateTime,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// coerceValue converts a single value of schema type typ.
+func coerceValue(f metaField, typ string, value any) (any, error) {
+	s := strings.TrimSpace(fmt.Sprint(value))
+	switch typ {
+	case "string":
+		return fmt.Sprint(value), nil
+	case "number":
+		if n, ok := value.(float64); ok {
+			return n, nil
+		}
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", s)
+		}
+		return n, nil
+	case "date", "datetime":
+		for _, layout := range dateLayouts {
+			t, err := time.Parse(layout, s)
+			if err != nil {
+				continue
+			}
+			if typ == "date" {
+				return t.Format("2006-01-02"), nil
+			}
+			return t.Format(jiraDateTime), nil
+		}
+		return nil, fmt.Errorf("%q is not a date, use YYYY-MM-DD", s)
+	case "user", "group", "watches":
+		return map[string]any{"name": s}, nil
+	case "project", "issuelink":
+		return map[string]any{"key": s}, nil
+	case "timetracking":
+		return map[string]any{"originalEstimate": s}, nil
+	case "option", "priority", "resolution", "issuetype", "securitylevel", "version", "component":
+		return matchAllowed(f, typ, s)
+	}
+	return value, nil
+}
+
+// matchAllowed finds s among the allowed values of f by name, value or id
+// and refers to it by id. Without allowed values, s is sent by name.
+func matchAllowed(f metaField, typ, s string) (any, error) {
+	if len(f.AllowedValues) == 0 {
+		if typ == "option" {
+			return map[string]any{"value": s}, nil
+		}
+		return map[string]any{"name": s}, nil
+	}
+	for _, allowed := range f.AllowedValues {
+		for _, key := range []string{"name", "value", "id"} {
+			if v, ok := allowed[key].(string); ok && strings.EqualFold(v, s) {
+				return map[string]any{"id": fmt.Sprint(allowed["id"])}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%q is not allowed; allowed values: %s", s, strings.Join(allowedNames(f), ", "))
+}
+
+// allowedNames returns the names of the allowed values of f.
+func allowedNames(f metaField) []string {
+	names := make([]string, 0, len(f.AllowedValues))
+	for _, allowed := range f.AllowedValues {
+		for _, key := range []string{"name", "value", "id"} {
+			if v, ok := allowed[key].(string); ok {
+				names = append(names, v)
+				break
+			}
+		}
+	}
+	return names
+}
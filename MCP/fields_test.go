@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+)
+
+func TestCoerceField(t *testing.T) {
+	priority := metaField{Name: "Priority", Schema: fieldSchema{Type: "priority"}, AllowedValues: []map[string]any{
+		{"id": "1", "name": "Highest"}, {"id": "3", "name": "Medium"},
+	}}
+	versions := metaField{Name: "Fix Version/s", Schema: fieldSchema{Type: "array", Items: "version"}, AllowedValues: []map[string]any{
+		{"id": "10000", "name": "1.0"}, {"id": "10001", "name": "1.1"},
+	}}
+	tests := []struct {
+		name    string
+		field   metaField
+		value   any
+		want    string // JSON
+		wantErr bool
+	}{
+		{"string", metaField{Schema: fieldSchema{Type: "string"}}, "Broken", `"Broken"`, false},
+		{"number from text", metaField{Schema: fieldSchema{Type: "number"}}, "5", `5`, false},
+		{"number", metaField{Schema: fieldSchema{Type: "number"}}, 3.5, `3.5`, false},
+		{"not a number", metaField{Schema: fieldSchema{Type: "number"}}, "five", ``, true},
+		{"date", metaField{Schema: fieldSchema{Type: "date"}}, "2017-11-01T09:00:00+01:00", `"2017-11-01"`, false},
+		{"datetime", metaField{Schema: fieldSchema{Type: "datetime"}}, "2017-11-01T09:00:00+01:00", `"2017-11-01T09:00:00.000+0100"`, false},
+		{"not a date", metaField{Schema: fieldSchema{Type: "date"}}, "tomorrow", ``, true},
+		{"user", metaField{Schema: fieldSchema{Type: "user"}}, "alice", `{"name":"alice"}`, false},
+		{"allowed value by name", priority, "highest", `{"id":"1"}`, false},
+		{"allowed value by id", priority, "3", `{"id":"3"}`, false},
+		{"value not allowed", priority, "Low", ``, true},
+		{"option without allowed values", metaField{Schema: fieldSchema{Type: "option"}}, "Red", `{"value":"Red"}`, false},
+		{"list from text", versions, "1.0, 1.1", `[{"id":"10000"},{"id":"10001"}]`, false},
+		{"list", versions, []any{"1.1"}, `[{"id":"10001"}]`, false},
+		{"single value for a list", metaField{Schema: fieldSchema{Type: "array", Items: "string"}}, "ui", `["ui"]`, false},
+		{"object passed through", priority, map[string]any{"name": "Low"}, `{"name":"Low"}`, false},
+	}
+	for _, tt := range tests {
+		got, err := coerceField(tt.field, tt.value)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: coerceField(%v) = %v, want an error", tt.name, tt.value, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: coerceField(%v): %v", tt.name, tt.value, err)
+			continue
+		}
+		if encoded, _ := json.Marshal(got); string(encoded) != tt.want {
+			t.Errorf("%s: coerceField(%v) = %s, want %s", tt.name, tt.value, encoded, tt.want)
+		}
+	}
+}
+
+func TestFieldResolverResolveAll(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id": "reporter", "name": "Reporter"}, {"id": "summary", "name": "Summary"}]`))
+	}))
+	defer srv.Close()
+	meta := map[string]metaField{
+		"summary":           {Name: "Summary", Schema: fieldSchema{Type: "string"}},
+		"customfield_10105": {Name: "Story Points", Schema: fieldSchema{Type: "number"}},
+		"customfield_10200": {Name: "Team", Schema: fieldSchema{Type: "string"}},
+		"customfield_10201": {Name: "Team", Schema: fieldSchema{Type: "option"}},
+	}
+	r := newFieldResolver(context.Background(), &config.APIConfig{BaseURL: srv.URL}, meta, "create screen of Bug in ABC")
+
+	got, problems := r.resolveAll(map[string]any{
+		"SUMMARY":      "Broken",
+		"story points": "3",
+		"Team":         "Core",
+		"Reporter":     "alice",
+		"Sprint":       "1",
+	})
+	want := map[string]any{"summary": "Broken", "customfield_10105": 3.0}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("resolveAll fields = %v, want %v", got, want)
+	}
+	wantProblems := []string{
+		`field "Reporter" (reporter) is not on the create screen of Bug in ABC`,
+		`unknown field "Sprint"`,
+		`field "Team" is ambiguous, use one of the ids customfield_10200, customfield_10201`,
+	}
+	if !slices.Equal(problems, wantProblems) {
+		t.Errorf("resolveAll problems = %q, want %q", problems, wantProblems)
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"log/slog"
+	"path"
+	"strings"
+
+	"github.com/jira-7-6-1/mcp-server/client"
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/jira-7-6-1/mcp-server/operations"
+)
+
+// unsafeGetTools are GET endpoints that nevertheless change server state and
+// are therefore excluded from read-only mode.
+var unsafeGetTools = map[string]bool{
+	"get_api_2_monitoring_jmx_startExposing": true,
+	"get_api_2_monitoring_jmx_stopExposing":  true,
+}
+
+// toolMethod returns the HTTP method a tool calls, e.g. "DELETE" for
+// jira_delete_issue. Unknown tools fall back to the method encoded in the
+// generated name, e.g. delete_api_2_issue_issueIdOrKey.
+func toolMethod(name string) string {
+	if method, ok := issueToolMethods[name]; ok {
+		return method
+	}
+	if op, ok := operations.Resolve(name); ok {
+		return op.Method
+	}
+	method, _, _ := strings.Cut(name, "_")
+	return strings.ToUpper(method)
+}
+
+func isReadOnlyTool(tool models.Tool) bool {
+	name := tool.Definition.Name
+	return client.IsSafeMethod(toolMethod(name)) && !unsafeGetTools[name]
+}
+
+func filterReadOnly(tools []models.Tool) []models.Tool {
+	filtered := make([]models.Tool, 0, len(tools))
+	for _, tool := range tools {
+		if isReadOnlyTool(tool) {
+			filtered = append(filtered, tool)
+		}
+	}
+	return filtered
+}
+
+// filterTools keeps the tools selected by the tool groups and allow globs of
+// cfg (all tools when neither is set) and then drops those matching a deny glob.
+func filterTools(tools []models.Tool, cfg *config.APIConfig) []models.Tool {
+	include := append(groupPatterns(cfg.ToolGroups), cfg.AllowTools...)
+	validatePatterns(include)
+	validatePatterns(cfg.DenyTools)
+
+	filtered := make([]models.Tool, 0, len(tools))
+	for _, tool := range tools {
+		names := toolNames(tool.Definition.Name)
+		if len(include) > 0 && !matchAny(include, names...) {
+			continue
+		}
+		if matchAny(cfg.DenyTools, names...) {
+			continue
+		}
+		filtered = append(filtered, tool)
+	}
+	return filtered
+}
+
+// groupPatterns expands group names into their tool-name globs.
+func groupPatterns(groups []string) []string {
+	var patterns []string
+	for _, group := range groups {
+		globs, ok := toolGroups[group]
+		if !ok {
+			slog.Warn("Unknown tool group ignored", "group", group)
+			continue
+		}
+		patterns = append(patterns, globs...)
+	}
+	return patterns
+}
+
+func validatePatterns(patterns []string) {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			slog.Warn("Invalid tool name pattern ignored", "pattern", pattern, "error", err)
+		}
+	}
+}
+
+// toolNames returns every name a tool is known by, so globs may use either
+// the generated or the friendly name.
+func toolNames(name string) []string {
+	names := []string{name}
+	if op, ok := operations.Resolve(name); ok {
+		names = append(names, op.Name, op.Legacy)
+	}
+	return names
+}
+
+func matchAny(patterns []string, names ...string) bool {
+	for _, pattern := range patterns {
+		for _, name := range names {
+			if ok, _ := path.Match(pattern, name); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestFilterReadOnly(t *testing.T) {
+	names := []string{
+		"get_api_2_issue_issueIdOrKey",
+		"post_api_2_issue",
+		"put_api_2_issue_issueIdOrKey",
+		"delete_api_2_issue_issueIdOrKey",
+		"get_api_2_monitoring_jmx_startExposing",
+		"get_api_2_project",
+	}
+	var tools []models.Tool
+	for _, name := range names {
+		tools = append(tools, models.Tool{Definition: mcp.NewTool(name)})
+	}
+
+	var got []string
+	for _, tool := range filterReadOnly(tools) {
+		got = append(got, tool.Definition.Name)
+	}
+	want := []string{"get_api_2_issue_issueIdOrKey", "get_api_2_project"}
+	if !slices.Equal(got, want) {
+		t.Errorf("filterReadOnly kept %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,406 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// formattedTools are globs of the tools that accept format and columns:
+// searches and the listings of worklogs, users, versions, components and
+// audit records, which read well as tables.
+var formattedTools = []string{
+	"*_api_2_search",
+	"jira_search",
+	"get_api_2_issue_issueIdOrKey_worklog*",
+	"get_api_2_worklog_*",
+	"post_api_2_worklog_list",
+	"get_api_2_user",
+	"get_api_2_user_*",
+	"get_api_2_group_member",
+	"get_api_2_version_*",
+	"get_api_2_project_projectIdOrKey_version*",
+	"get_api_2_component_*",
+	"get_api_2_project_projectIdOrKey_components",
+	"get_api_2_auditing_record",
+	"jira_issue_timeline",
+}
+
+// outputFormats are the values of the format parameter.
+var outputFormats = []string{"json", "markdown", "csv", "jsonl"}
+
+// leadingColumns come first in a table when present; the other columns
+// follow in alphabetical order.
+var leadingColumns = []string{"key", "id", "name", "displayName", "summary"}
+
+// formatTools adds the format and columns parameters to formattedTools.
+// The response is reformatted after the tool has run, so the tool itself
+// still returns JSON.
+func formatTools(tools []models.Tool) []models.Tool {
+	for i := range tools {
+		if !matchAny(formattedTools, toolNames(tools[i].Definition.Name)...) {
+			continue
+		}
+		mcp.WithString("format", mcp.Description("json (default), markdown for a table with the other top-level values listed above it, csv, or jsonl with one object per row. Nested fields are flattened into dotted columns such as fields.status.name"), mcp.Enum(outputFormats...))(&tools[i].Definition)
+		mcp.WithArray("columns", mcp.Description("dotted paths of the columns to return, in order, e.g. [\"key\", \"fields.summary\", \"fields.assignee\"]; a path to an object such as a user or status gives its name"), mcp.WithStringItems())(&tools[i].Definition)
+		tools[i].Handler = formatHandler(tools[i].Handler)
+	}
+	return tools
+}
+
+func formatHandler(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		format := request.GetString("format", "json")
+		columns := request.GetStringSlice("columns", nil)
+		request.Params.Arguments = withoutKey(withoutKey(request.GetArguments(), "format"), "columns")
+		if !slices.Contains(outputFormats, format) {
+			return mcp.NewToolResultError(fmt.Sprintf("Unknown format %q; use one of %s", format, strings.Join(outputFormats, ", "))), nil
+		}
+
+		result, err := next(ctx, request)
+		if err != nil || result.IsError || (format == "json" && len(columns) == 0) || len(result.Content) == 0 {
+			return result, err
+		}
+		text, ok := result.Content[0].(mcp.TextContent)
+		if !ok {
+			return result, nil
+		}
+		var response any
+		if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+			return result, nil // Not JSON, e.g. a plain text answer
+		}
+
+		table := tableOf(response, columns)
+		var out string
+		switch format {
+		case "json":
+			pretty, err := json.MarshalIndent(table.json(), "", "  ")
+			if err != nil {
+				return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+			}
+			out = string(pretty)
+		case "markdown":
+			out = table.markdown()
+		case "csv":
+			out, err = table.csv()
+			if err != nil {
+				return mcp.NewToolResultErrorFromErr("Failed to format CSV", err), nil
+			}
+		case "jsonl":
+			out, err = table.jsonl()
+			if err != nil {
+				return mcp.NewToolResultErrorFromErr("Failed to format JSON lines", err), nil
+			}
+		}
+		return mcp.NewToolResultText(out), nil
+	}
+}
+
+// table is a response reduced to rows of flattened values. meta holds the
+// top-level values beside the rows, such as total and startAt, and rowsKey
+// the name of the list the rows came from.
+type table struct {
+	meta    map[string]any
+	rowsKey string
+	columns []string
+	rows    []map[string]any
+}
+
+// tableOf picks the rows of a response: the response itself when it is a
+// list, else its longest list of objects, e.g. issues or worklogs, else the
+// response as a single row. Without columns every flattened path found in
+// a row becomes one, leaving out links and avatars.
+func tableOf(response any, columns []string) table {
+	t := table{meta: map[string]any{}}
+	var records []any
+	switch v := response.(type) {
+	case []any:
+		records = v
+	case map[string]any:
+		for _, key := range sortedKeys(v) {
+			if list, ok := v[key].([]any); ok && len(list) > len(records) && isObjectList(list) {
+				t.rowsKey, records = key, list
+			}
+		}
+		if t.rowsKey == "" {
+			records = []any{v}
+		}
+		for key, value := range v {
+			if key != t.rowsKey && !noiseKeys[key] {
+				if _, nested := value.(map[string]any); !nested && value != nil && t.rowsKey != "" {
+					t.meta[key] = value
+				}
+			}
+		}
+	default:
+		records = []any{response}
+	}
+
+	seen := map[string]bool{}
+	for _, record := range records {
+		row := map[string]any{}
+		if len(columns) > 0 {
+			for _, column := range columns {
+				row[column] = cellValue(lookupPath(record, column))
+			}
+		} else {
+			flatten("", record, row)
+			for column := range row {
+				if !seen[column] {
+					seen[column] = true
+					t.columns = append(t.columns, column)
+				}
+			}
+		}
+		t.rows = append(t.rows, row)
+	}
+	if len(columns) > 0 {
+		t.columns = columns
+	} else {
+		slices.SortFunc(t.columns, compareColumns)
+	}
+	return t
+}
+
+// isObjectList reports whether a list holds objects, so that lists of
+// labels or ids stay a column instead of becoming the rows.
+func isObjectList(list []any) bool {
+	for _, item := range list {
+		if _, ok := item.(map[string]any); !ok {
+			return false
+		}
+	}
+	return len(list) > 0
+}
+
+// flatten stores the leaves of v in row under dotted paths, leaving out
+// empty values. Lists of plain values and of named objects become one
+// comma-separated cell.
+func flatten(prefix string, v any, row map[string]any) {
+	switch v := v.(type) {
+	case nil:
+	case map[string]any:
+		for key, inner := range v {
+			if noiseKeys[key] {
+				continue
+			}
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			flatten(path, inner, row)
+		}
+	default:
+		if prefix == "" {
+			prefix = "value"
+		}
+		row[prefix] = cellValue(v)
+	}
+}
+
+// lookupPath returns the value at a dotted path, where a number selects an
+// element of a list.
+func lookupPath(v any, path string) any {
+	for _, part := range strings.Split(path, ".") {
+		switch node := v.(type) {
+		case map[string]any:
+			v = node[part]
+		case []any:
+			i, err := strconv.Atoi(part)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil
+			}
+			v = node[i]
+		default:
+			return nil
+		}
+	}
+	return v
+}
+
+// cellValue reduces a value to what fits in one cell: objects become their
+// name as in displayValue, lists a comma-separated string, and anything
+// without a name compact JSON.
+func cellValue(v any) any {
+	switch v := displayValue(v).(type) {
+	case map[string]any:
+		return compactJSON(cleanValue(v))
+	case []any:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			parts = append(parts, cellText(cellValue(item)))
+		}
+		return strings.Join(parts, ", ")
+	default:
+		return v
+	}
+}
+
+// cellText writes a cell value as text.
+func cellText(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return compactJSON(v)
+	}
+}
+
+func compactJSON(v any) string {
+	text, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(text)
+}
+
+func compareColumns(a, b string) int {
+	rank := func(column string) int {
+		if i := slices.Index(leadingColumns, column); i >= 0 {
+			return i
+		}
+		return len(leadingColumns)
+	}
+	if ra, rb := rank(a), rank(b); ra != rb {
+		return ra - rb
+	}
+	return strings.Compare(a, b)
+}
+
+// json returns the table as the top-level values with the flattened rows,
+// or just the rows when there are no other values.
+func (t table) json() any {
+	rows := make([]orderedRow, 0, len(t.rows))
+	for _, row := range t.rows {
+		rows = append(rows, orderedRow{columns: t.columns, values: row})
+	}
+	if t.rowsKey == "" {
+		return rows
+	}
+	out := map[string]any{t.rowsKey: rows}
+	for key, value := range t.meta {
+		out[key] = value
+	}
+	return out
+}
+
+// orderedRow is a row that is written as JSON with the columns of the table
+// in their order, rather than sorted like map keys.
+type orderedRow struct {
+	columns []string
+	values  map[string]any
+}
+
+func (r orderedRow) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString("{")
+	for i, column := range r.columns {
+		key, err := json.Marshal(column)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(r.values[column])
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.Write(key)
+		b.WriteString(":")
+		b.Write(value)
+	}
+	b.WriteString("}")
+	return b.Bytes(), nil
+}
+
+// markdown writes the top-level values as a list followed by the rows as
+// a table. A single object without rows becomes a Field | Value table.
+func (t table) markdown() string {
+	var b strings.Builder
+	for _, key := range sortedKeys(t.meta) {
+		fmt.Fprintf(&b, "- **%s**: %s\n", key, markdownCell(t.meta[key]))
+	}
+	if len(t.meta) > 0 {
+		b.WriteString("\n")
+	}
+	if t.rowsKey == "" && len(t.rows) == 1 {
+		b.WriteString("| Field | Value |\n| --- | --- |\n")
+		for _, column := range t.columns {
+			fmt.Fprintf(&b, "| %s | %s |\n", markdownCell(column), markdownCell(t.rows[0][column]))
+		}
+		return b.String()
+	}
+	if len(t.rows) == 0 {
+		b.WriteString("No results.\n")
+		return b.String()
+	}
+	header := make([]string, len(t.columns))
+	rule := make([]string, len(t.columns))
+	for i, column := range t.columns {
+		header[i], rule[i] = markdownCell(column), "---"
+	}
+	fmt.Fprintf(&b, "| %s |\n| %s |\n", strings.Join(header, " | "), strings.Join(rule, " | "))
+	for _, row := range t.rows {
+		cells := make([]string, len(t.columns))
+		for i, column := range t.columns {
+			cells[i] = markdownCell(row[column])
+		}
+		fmt.Fprintf(&b, "| %s |\n", strings.Join(cells, " | "))
+	}
+	return b.String()
+}
+
+// markdownCell escapes a value for a table cell.
+func markdownCell(v any) string {
+	text := cellText(v)
+	text = strings.ReplaceAll(text, "|", `\|`)
+	text = strings.ReplaceAll(text, "\r\n", "<br>")
+	return strings.ReplaceAll(text, "\n", "<br>")
+}
+
+// csv writes a header line and one line per row.
+func (t table) csv() (string, error) {
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	if err := w.Write(t.columns); err != nil {
+		return "", err
+	}
+	for _, row := range t.rows {
+		record := make([]string, len(t.columns))
+		for i, column := range t.columns {
+			record[i] = cellText(row[column])
+		}
+		if err := w.Write(record); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	return b.String(), w.Error()
+}
+
+// jsonl writes one flattened JSON object per row, with the keys in column
+// order.
+func (t table) jsonl() (string, error) {
+	var b strings.Builder
+	for _, row := range t.rows {
+		line, err := json.Marshal(orderedRow{columns: t.columns, values: row})
+		if err != nil {
+			return "", err
+		}
+		b.Write(line)
+		b.WriteString("\n")
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func decode(t *testing.T, text string) any {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal([]byte(text), &v); err != nil {
+		t.Fatalf("%s: %v", text, err)
+	}
+	return v
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		in   string
+		want map[string]any
+	}{
+		{`"text"`, map[string]any{"value": "text"}},
+		{`{"key": "ABC-1", "self": "https://jira/rest/api/2/issue/1"}`, map[string]any{"key": "ABC-1"}},
+		{`{"fields": {"summary": "Fix it", "duedate": null}}`, map[string]any{"fields.summary": "Fix it"}},
+		{`{"fields": {"status": {"name": "Done", "iconUrl": "x"}}}`, map[string]any{"fields.status.name": "Done"}},
+		{`{"labels": ["a", "b"], "count": 2}`, map[string]any{"labels": "a, b", "count": 2.0}},
+		{`{"fixVersions": [{"name": "1.0"}, {"name": "1.1"}]}`, map[string]any{"fixVersions": "1.0, 1.1"}},
+		{`{"links": [{"type": "Blocks"}]}`, map[string]any{"links": `{"type":"Blocks"}`}},
+		{`{"assignee": {"avatarUrls": {"16x16": "x"}}}`, map[string]any{}},
+	}
+	for _, tt := range tests {
+		row := map[string]any{}
+		flatten("", decode(t, tt.in), row)
+		if !maps.Equal(row, tt.want) {
+			t.Errorf("flatten(%s) = %v, want %v", tt.in, row, tt.want)
+		}
+	}
+}
+
+func TestTableOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		columns  []string
+		wantKey  string
+		wantCols []string
+	}{
+		{
+			name:     "leading columns first",
+			in:       `[{"summary": "S", "created": "2024", "key": "ABC-1", "id": "10"}]`,
+			wantCols: []string{"key", "id", "summary", "created"},
+		},
+		{
+			name:     "longest object list",
+			in:       `{"total": 2, "labels": ["a", "b", "c"], "issues": [{"key": "A-1", "fields": {"summary": "S"}}, {"key": "A-2", "fields": {"priority": {"name": "High"}}}]}`,
+			wantKey:  "issues",
+			wantCols: []string{"key", "fields.priority.name", "fields.summary"},
+		},
+		{
+			name:     "single object",
+			in:       `{"name": "Admin", "description": "D", "self": "x"}`,
+			wantCols: []string{"name", "description"},
+		},
+		{
+			name:     "chosen columns",
+			in:       `{"issues": [{"key": "A-1", "fields": {"summary": "S"}}]}`,
+			columns:  []string{"fields.summary", "key"},
+			wantKey:  "issues",
+			wantCols: []string{"fields.summary", "key"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tableOf(decode(t, tt.in), tt.columns)
+			if table.rowsKey != tt.wantKey {
+				t.Errorf("rowsKey = %q, want %q", table.rowsKey, tt.wantKey)
+			}
+			if !slices.Equal(table.columns, tt.wantCols) {
+				t.Errorf("columns = %q, want %q", table.columns, tt.wantCols)
+			}
+		})
+	}
+}
+
+func TestTableJSONColumnOrder(t *testing.T) {
+	table := tableOf(decode(t, `{"total": 1, "issues": [{"key": "A-1", "fields": {"summary": "S", "assignee": {"name": "bob"}}}]}`), []string{"key", "fields.summary", "fields.assignee"})
+
+	data, err := json.Marshal(table.json())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"issues":[{"key":"A-1","fields.summary":"S","fields.assignee":"bob"}],"total":1}`; string(data) != want {
+		t.Errorf("json = %s, want %s", data, want)
+	}
+	lines, err := table.jsonl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "{\"key\":\"A-1\",\"fields.summary\":\"S\",\"fields.assignee\":\"bob\"}\n"; lines != want {
+		t.Errorf("jsonl = %q, want %q", lines, want)
+	}
+}
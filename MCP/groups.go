@@ -0,0 +1,93 @@
+package main
+
+// toolGroups are curated sets of tool-name globs that can be enabled with
+// TOOL_GROUPS instead of registering all tools. A tool may belong to more
+// than one group.
+var toolGroups = map[string][]string{
+	"issues": {
+		"*_api_2_issue",
+		"*_api_2_issue_*",
+		"*_api_2_issueLink",
+		"*_api_2_issueLink_*",
+		"*_api_2_search",
+		"*_api_2_attachment_*",
+		"*_api_2_comment_*",
+		"*_api_2_worklog_*",
+		"*_api_2_jql_*",
+		"get_api_2_customFieldOption_*",
+		"get_api_2_field",
+		"get_api_2_issuetype*",
+		"get_api_2_issueLinkType*",
+		"get_api_2_mypermissions",
+		"get_api_2_priority*",
+		"get_api_2_resolution*",
+		"get_api_2_status*",
+		"jira_update_issue",
+		"jira_transition_issue",
+		"jira_build_jql",
+		"jira_search",
+		"jira_issue_timeline",
+	},
+	"projects": {
+		"*_api_2_project",
+		"*_api_2_project_*",
+		"*_api_2_projectCategory*",
+		"*_api_2_projectvalidate_*",
+		"*_api_2_component*",
+		"*_api_2_version*",
+		"*_api_2_role*",
+	},
+	"filters": {
+		"*_api_2_filter*",
+		"*_api_2_dashboard*",
+	},
+	"users": {
+		"*_api_2_user*",
+		"*_api_2_group*",
+		"*_api_2_myself*",
+		"*_api_2_mypreferences",
+		"*_api_2_password_policy*",
+		"*_auth_1_*",
+	},
+	"admin": {
+		"*_api_2_application-properties*",
+		"*_api_2_applicationrole*",
+		"*_api_2_settings_*",
+		"*_api_2_reindex*",
+		"*_api_2_index_*",
+		"*_api_2_upgrade",
+		"*_api_2_licenseValidator",
+		"*_api_2_configuration",
+		"*_api_2_auditing_*",
+		"*_api_2_permissions",
+		"*_api_2_permissionscheme*",
+		"*_api_2_notificationscheme*",
+		"*_api_2_issuesecurityschemes*",
+		"*_api_2_securitylevel_*",
+		"*_api_2_screens_*",
+		"*_api_2_field",
+		"*_api_2_customFieldOption_*",
+		"*_api_2_issuetype*",
+		"*_api_2_issueLinkType*",
+		"*_api_2_priority*",
+		"*_api_2_resolution*",
+		"*_api_2_status*",
+		"*_api_2_avatar_*",
+		"*_api_2_universal_avatar_*",
+	},
+	"workflow-schemes": {
+		"*_api_2_workflowscheme*",
+		"*_api_2_workflow",
+		"*_api_2_workflow_*",
+	},
+	"cluster-zdu": {
+		"*_api_2_cluster_zdu_*",
+	},
+	"monitoring": {
+		"*_api_2_monitoring_*",
+		"get_api_2_index_summary",
+		"get_api_2_reindex_progress",
+		"get_api_2_serverInfo",
+		"get_api_2_auditing_record",
+	},
+}
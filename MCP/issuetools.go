@@ -0,0 +1,46 @@
+package main
+
+import (
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/models"
+)
+
+// issueToolMethods are the HTTP methods that describe the effect of the
+// hand-written issue tools, which have no generated name to derive one from.
+// Read-only mode, hints and dry runs use them like those of generated tools.
+var issueToolMethods = map[string]string{
+	"jira_update_issue":     "PUT",
+	"jira_transition_issue": "POST",
+	"jira_build_jql":        "GET",
+	"jira_search":           "GET",
+	"jira_issue_timeline":   "GET",
+}
+
+// issueTools swaps in hand-written handlers for generated tools that need
+// more than one Jira call or a request body to be usable, such as resolving
+// field names before creating an issue or sending a comment, and adds the
+// hand-written issue tools. Tools are matched by their generated name, so
+// issueTools runs before friendlyNames.
+func issueTools(tools []models.Tool, cfg *config.APIConfig) []models.Tool {
+	for i := range tools {
+		switch tools[i].Definition.Name {
+		case "post_api_2_issue":
+			tools[i] = createIssueTool(tools[i], cfg)
+		case "post_api_2_issue_issueIdOrKey_comment":
+			tools[i] = addCommentTool(tools[i], cfg)
+		case "put_api_2_issue_issueIdOrKey_comment_id":
+			tools[i] = updateCommentTool(tools[i], cfg)
+		case "put_api_2_issue_issueIdOrKey":
+			tools[i] = editIssueTool(tools[i], cfg)
+		case "post_api_2_user":
+			tools[i] = createUserTool(tools[i], cfg)
+		}
+	}
+	return append(tools,
+		updateIssueTool(cfg),
+		transitionIssueTool(cfg),
+		buildJQLTool(cfg),
+		searchTool(cfg),
+		issueTimelineTool(cfg),
+	)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/jira-7-6-1/mcp-server/client"
+	"github.com/jira-7-6-1/mcp-server/config"
+)
+
+// jiraRequest sends a request with a JSON body to a Jira REST path such as
+// /api/2/issue/bulk and returns the response body. It is used where the
+// generated tools cannot help because they do not send a request body.
+func jiraRequest(ctx context.Context, cfg *config.APIConfig, method, path string, body any) ([]byte, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("encode request body: %w", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, cfg.BaseURL+path, reader)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return data, fmt.Errorf("API error: %s", data)
+	}
+	return data, nil
+}
+
+// getJSON sends a GET request to a Jira REST path and decodes the response.
+func getJSON(ctx context.Context, cfg *config.APIConfig, path string, v any) error {
+	data, err := jiraRequest(ctx, cfg, "GET", path, nil)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// cachedGet is getJSON for metadata that rarely changes, such as the JQL
+// field list. Responses are kept like completion lookups.
+func cachedGet(ctx context.Context, cfg *config.APIConfig, path string, v any) error {
+	key, err := cacheKey(cfg, path, nil)
+	if err != nil {
+		return err
+	}
+	text, ok := completionCache.get(key)
+	if !ok {
+		data, err := jiraRequest(ctx, cfg, "GET", path, nil)
+		if err != nil {
+			return err
+		}
+		text = string(data)
+		completionCache.put(key, text)
+	}
+	return json.Unmarshal([]byte(text), v)
+}
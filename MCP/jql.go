@@ -0,0 +1,412 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func buildJQLTool(cfg *config.APIConfig) models.Tool {
+	list := func(what string) mcp.PropertyOption {
+		return mcp.Description(what + "; a string or a list, which matches any of its values")
+	}
+	tool := mcp.NewTool("jira_build_jql",
+		mcp.WithDescription("Builds a correctly quoted JQL query from structured criteria, all of which must match, and optionally validates it. With validate, the query is checked against the fields and functions Jira knows and run with validateQuery=strict and maxResults=0; errors come back as a list. Give jql instead of criteria to validate an existing query."),
+		mcp.WithString("jql", mcp.Description("an existing query to validate instead of building one")),
+		mcp.WithAny("project", list("project keys")),
+		mcp.WithAny("issueType", list("issue type names")),
+		mcp.WithAny("status", list("status names")),
+		mcp.WithAny("priority", list("priority names")),
+		mcp.WithAny("resolution", list("resolution names; \"Unresolved\" matches open issues")),
+		mcp.WithAny("assignee", list("usernames; \"me\" is the current user, \"unassigned\" matches issues without one")),
+		mcp.WithAny("reporter", list("usernames; \"me\" is the current user")),
+		mcp.WithAny("labels", list("labels")),
+		mcp.WithAny("component", list("component names")),
+		mcp.WithAny("fixVersion", list("version names")),
+		mcp.WithString("text", mcp.Description("words to search for in summary, description, environment and comments")),
+		mcp.WithString("createdAfter", mcp.Description("created on or after: YYYY-MM-DD, a relative date such as -7d, or a function such as startOfWeek()")),
+		mcp.WithString("createdBefore", mcp.Description("created before, in the same formats")),
+		mcp.WithString("updatedAfter", mcp.Description("updated on or after, in the same formats")),
+		mcp.WithString("updatedBefore", mcp.Description("updated before, in the same formats")),
+		mcp.WithString("resolvedAfter", mcp.Description("resolved on or after, in the same formats")),
+		mcp.WithString("resolvedBefore", mcp.Description("resolved before, in the same formats")),
+		mcp.WithObject("fields", mcp.Description("other fields by display name or JQL name, e.g. {\"Epic Link\": \"ABC-12\", \"Sprint\": \"openSprints()\"}")),
+		mcp.WithString("where", mcp.Description("a raw JQL condition to add, e.g. \"sprint in openSprints()\"")),
+		mcp.WithAny("orderBy", mcp.Description("fields to sort by, each optionally followed by ASC or DESC, e.g. [\"priority DESC\", \"updated\"]")),
+		mcp.WithBoolean("validate", mcp.Description("check the query against Jira and return any errors")),
+	)
+	return models.Tool{Definition: tool, Handler: buildJQLHandler(cfg)}
+}
+
+// jqlCriteria maps the list parameters of jira_build_jql to JQL fields.
+var jqlCriteria = []struct{ param, field string }{
+	{"project", "project"},
+	{"issueType", "issuetype"},
+	{"status", "status"},
+	{"priority", "priority"},
+	{"resolution", "resolution"},
+	{"assignee", "assignee"},
+	{"reporter", "reporter"},
+	{"labels", "labels"},
+	{"component", "component"},
+	{"fixVersion", "fixVersion"},
+}
+
+// jqlDateCriteria maps the date parameters of jira_build_jql to clauses.
+var jqlDateCriteria = []struct{ param, field, operator string }{
+	{"createdAfter", "created", ">="},
+	{"createdBefore", "created", "<"},
+	{"updatedAfter", "updated", ">="},
+	{"updatedBefore", "updated", "<"},
+	{"resolvedAfter", "resolved", ">="},
+	{"resolvedBefore", "resolved", "<"},
+}
+
+// jqlResult is the output of jira_build_jql.
+type jqlResult struct {
+	JQL        string         `json:"jql"`
+	Validation *jqlValidation `json:"validation,omitempty"`
+}
+
+type jqlValidation struct {
+	Valid    bool       `json:"valid"`
+	Total    *int       `json:"total,omitempty"` // Issues the query matches
+	Errors   []jqlError `json:"errors,omitempty"`
+	Warnings []string   `json:"warnings,omitempty"`
+}
+
+// jqlError is one problem with a query.
+type jqlError struct {
+	Message     string   `json:"message"`
+	Name        string   `json:"name,omitempty"`        // Unknown field or function
+	Suggestions []string `json:"suggestions,omitempty"` // Known names close to it
+}
+
+func buildJQLHandler(cfg *config.APIConfig) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := request.GetArguments()
+		out := jqlResult{JQL: request.GetString("jql", "")}
+		var errs []jqlError
+		if out.JQL == "" {
+			var err error
+			out.JQL, errs, err = buildJQL(ctx, cfg, args)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+		if request.GetBool("validate", false) {
+			v, err := validateJQL(ctx, cfg, out.JQL)
+			if err != nil {
+				return mcp.NewToolResultErrorFromErr("Failed to validate the query", err), nil
+			}
+			v.Errors = append(errs, v.Errors...)
+			v.Valid = len(v.Errors) == 0
+			out.Validation = &v
+		} else if len(errs) > 0 {
+			out.Validation = &jqlValidation{Errors: errs}
+		}
+
+		pretty, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+		}
+		return mcp.NewToolResultText(string(pretty)), nil
+	}
+}
+
+// jqlAutocomplete is the part of /api/2/jql/autocompletedata used to check
+// field and function names.
+type jqlAutocomplete struct {
+	VisibleFieldNames []struct {
+		Value       string `json:"value"`
+		DisplayName string `json:"displayName"`
+		CFID        string `json:"cfid"`
+	} `json:"visibleFieldNames"`
+	VisibleFunctionNames []struct {
+		Value string `json:"value"`
+	} `json:"visibleFunctionNames"`
+}
+
+func autocompleteData(ctx context.Context, cfg *config.APIConfig) (jqlAutocomplete, error) {
+	var data jqlAutocomplete
+	err := cachedGet(ctx, cfg, "/api/2/jql/autocompletedata", &data)
+	return data, err
+}
+
+// buildJQL turns the criteria in args into a query. Fields given by name
+// that Jira does not know are returned as errors and left out.
+func buildJQL(ctx context.Context, cfg *config.APIConfig, args map[string]any) (string, []jqlError, error) {
+	isFunction := knownFunctions(ctx, cfg)
+	var clauses []string
+	for _, c := range jqlCriteria {
+		values := stringList(args[c.param])
+		if len(values) == 0 {
+			continue
+		}
+		for i, v := range values {
+			values[i] = jqlOperand(c.field, v, isFunction)
+		}
+		clauses = append(clauses, jqlClause(c.field, values))
+	}
+	if text := strings.TrimSpace(fmt.Sprint(args["text"])); args["text"] != nil && text != "" {
+		clauses = append(clauses, "text ~ "+jqlString(escapeJQLText(text)))
+	}
+	for _, c := range jqlDateCriteria {
+		if v, _ := args[c.param].(string); v != "" {
+			clauses = append(clauses, fmt.Sprintf("%s %s %s", c.field, c.operator, jqlOperand(c.field, v, isFunction)))
+		}
+	}
+
+	var errs []jqlError
+	if fields, _ := args["fields"].(map[string]any); len(fields) > 0 {
+		data, err := autocompleteData(ctx, cfg)
+		if err != nil {
+			return "", nil, fmt.Errorf("cannot look up JQL field names: %w", err)
+		}
+		for _, name := range sortedKeys(fields) {
+			field, ok := data.clauseName(name)
+			if !ok {
+				errs = append(errs, jqlError{Message: fmt.Sprintf("field %q does not exist or you cannot search it", name), Name: name, Suggestions: data.similarFields(name)})
+				continue
+			}
+			values := stringList(fields[name])
+			for i, v := range values {
+				values[i] = jqlOperand(field, v, isFunction)
+			}
+			if len(values) > 0 {
+				clauses = append(clauses, jqlClause(jqlFieldName(field), values))
+			}
+		}
+	}
+	if where, _ := args["where"].(string); strings.TrimSpace(where) != "" {
+		clauses = append(clauses, "("+strings.TrimSpace(where)+")")
+	}
+
+	jql := strings.Join(clauses, " AND ")
+	if order := stringList(args["orderBy"]); len(order) > 0 {
+		for i, o := range order {
+			field, direction := strings.TrimSpace(o), ""
+			if space := strings.LastIndex(field, " "); space > 0 {
+				if d := strings.ToUpper(field[space+1:]); d == "ASC" || d == "DESC" {
+					field, direction = strings.TrimSpace(field[:space]), " "+d
+				}
+			}
+			order[i] = jqlFieldName(field) + direction
+		}
+		jql = strings.TrimSpace(jql + " ORDER BY " + strings.Join(order, ", "))
+	}
+	return jql, errs, nil
+}
+
+// jqlClause matches field against one or more values.
+func jqlClause(field string, values []string) string {
+	if len(values) == 1 {
+		if values[0] == "EMPTY" {
+			return field + " is EMPTY"
+		}
+		return field + " = " + values[0]
+	}
+	return field + " in (" + strings.Join(values, ", ") + ")"
+}
+
+// jqlFunction matches a JQL function call such as currentUser(),
+// startOfWeek(-1) or membersOf("jira-users"), whose arguments are words,
+// numbers or quoted strings.
+var jqlFunction = regexp.MustCompile(`^([A-Za-z]\w*)\(\s*(?:(?:[\w.+-]+|"(?:[^"\\]|\\.)*")(?:\s*,\s*(?:[\w.+-]+|"(?:[^"\\]|\\.)*"))*)?\s*\)$`)
+
+// jqlOperand quotes v unless it is a call of a function that isFunction
+// knows, and maps the shortcuts the tool accepts for users and resolutions.
+func jqlOperand(field, v string, isFunction func(name string) bool) string {
+	v = strings.TrimSpace(v)
+	if m := jqlFunction.FindStringSubmatch(v); m != nil && isFunction(m[1]) {
+		return v
+	}
+	switch {
+	case (field == "assignee" || field == "reporter") && strings.EqualFold(v, "me"):
+		return "currentUser()"
+	case field == "assignee" && strings.EqualFold(v, "unassigned"),
+		field == "resolution" && strings.EqualFold(v, "unresolved"):
+		return "EMPTY"
+	}
+	return jqlString(v)
+}
+
+// knownFunctions returns a check of function names against the autocomplete
+// data, which it fetches on first use. Without the data, values that look
+// like function calls are quoted like any other.
+func knownFunctions(ctx context.Context, cfg *config.APIConfig) func(name string) bool {
+	var once sync.Once
+	var data jqlAutocomplete
+	var err error
+	return func(name string) bool {
+		once.Do(func() {
+			if data, err = autocompleteData(ctx, cfg); err != nil {
+				logging.FromContext(ctx).Warn("function list unavailable, quoting JQL function calls", "error", err)
+			}
+		})
+		return err == nil && data.knownFunction(name)
+	}
+}
+
+// jqlWordName matches field names that need no quotes, e.g. cf[10105].
+var jqlWordName = regexp.MustCompile(`^[\w.\[\]]+$`)
+
+// jqlFieldName quotes a field name that is not a single word, such as a
+// custom field's display name. Jira's autocomplete data has them quoted.
+func jqlFieldName(name string) string {
+	if jqlWordName.MatchString(name) || strings.HasPrefix(name, `"`) {
+		return name
+	}
+	return jqlString(name)
+}
+
+// escapeJQLText escapes the characters that have a meaning in text
+// searches, so that they are searched for literally.
+func escapeJQLText(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(`+-&|!(){}[]^~*?\:`, r) {
+			b.WriteRune('\\') // Doubled by jqlString, as JQL requires
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// stringList accepts a string, which may be comma-separated, or a list.
+func stringList(v any) []string {
+	var out []string
+	switch v := v.(type) {
+	case string:
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				out = append(out, s)
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if s := strings.TrimSpace(fmt.Sprint(item)); s != "" {
+				out = append(out, s)
+			}
+		}
+	case nil:
+	default:
+		out = append(out, fmt.Sprint(v))
+	}
+	return out
+}
+
+// clauseName returns the name to use in JQL for a field given by display
+// name, JQL name or custom field id.
+func (d jqlAutocomplete) clauseName(name string) (string, bool) {
+	for _, f := range d.VisibleFieldNames {
+		if strings.EqualFold(f.Value, name) || strings.EqualFold(f.CFID, name) {
+			return f.Value, true
+		}
+	}
+	for _, f := range d.VisibleFieldNames {
+		if strings.EqualFold(f.DisplayName, name) || strings.EqualFold(strings.Split(f.DisplayName, " - ")[0], name) {
+			return f.Value, true
+		}
+	}
+	return "", false
+}
+
+// similarFields suggests known field names that contain name or the other
+// way round.
+func (d jqlAutocomplete) similarFields(name string) []string {
+	var names []string
+	for _, f := range d.VisibleFieldNames {
+		names = append(names, f.Value)
+	}
+	return similarNames(name, names)
+}
+
+func (d jqlAutocomplete) knownFunction(name string) bool {
+	for _, f := range d.VisibleFunctionNames {
+		if strings.EqualFold(strings.SplitN(f.Value, "(", 2)[0], name) {
+			return true
+		}
+	}
+	return false
+}
+
+func similarNames(name string, candidates []string) []string {
+	const maxSuggestions = 5
+	name = strings.ToLower(strings.Trim(name, `"`))
+	var similar []string
+	for _, c := range candidates {
+		lower := strings.ToLower(strings.Trim(c, `"`))
+		if name != "" && (strings.Contains(lower, name) || strings.Contains(name, lower)) {
+			similar = appendUnique(similar, c)
+		}
+		if len(similar) == maxSuggestions {
+			break
+		}
+	}
+	return similar
+}
+
+// jqlKeywords can be followed by a parenthesis without being a function.
+var jqlKeywords = map[string]bool{
+	"and": true, "or": true, "not": true, "in": true, "was": true, "changed": true,
+	"by": true, "during": true, "before": true, "after": true, "on": true, "from": true, "to": true,
+}
+
+// jqlCall finds function calls in a query outside quoted strings.
+var jqlCall = regexp.MustCompile(`"(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'|([A-Za-z]\w*)\s*\(`)
+
+// validateJQL checks the functions of a query against autocomplete data
+// and has Jira parse it strictly without returning any issues.
+func validateJQL(ctx context.Context, cfg *config.APIConfig, jql string) (jqlValidation, error) {
+	var v jqlValidation
+	data, err := autocompleteData(ctx, cfg)
+	if err != nil {
+		v.Warnings = append(v.Warnings, fmt.Sprintf("field and function names were not checked: %s", err))
+	} else {
+		for _, m := range jqlCall.FindAllStringSubmatch(jql, -1) {
+			if name := m[1]; name != "" && !jqlKeywords[strings.ToLower(name)] && !data.knownFunction(name) {
+				var functions []string
+				for _, f := range data.VisibleFunctionNames {
+					functions = append(functions, f.Value)
+				}
+				v.Errors = append(v.Errors, jqlError{Message: fmt.Sprintf("unknown JQL function %s()", name), Name: name, Suggestions: similarNames(name, functions)})
+			}
+		}
+	}
+
+	query := url.Values{"jql": {jql}, "validateQuery": {"strict"}, "maxResults": {"0"}, "fields": {"key"}}
+	body, err := jiraRequest(ctx, cfg, "GET", "/api/2/search?"+query.Encode(), nil)
+	var result struct {
+		Total         *int              `json:"total"`
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+		Warnings      []string          `json:"warningMessages"`
+	}
+	if jsonErr := json.Unmarshal(body, &result); jsonErr != nil {
+		if err == nil {
+			err = jsonErr
+		}
+		return v, err
+	}
+	for _, m := range result.ErrorMessages {
+		v.Errors = append(v.Errors, jqlError{Message: m})
+	}
+	for _, field := range sortedKeys(result.Errors) {
+		v.Errors = append(v.Errors, jqlError{Message: result.Errors[field], Name: field})
+	}
+	v.Warnings = append(v.Warnings, result.Warnings...)
+	v.Total = result.Total
+	v.Valid = len(v.Errors) == 0
+	return v, nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestJQLOperand(t *testing.T) {
+	known := func(name string) bool {
+		switch strings.ToLower(name) {
+		case "currentuser", "startofweek", "latestreleasedversion", "membersof":
+			return true
+		}
+		return false
+	}
+	tests := []struct{ field, in, want string }{
+		{"status", "Done", `"Done"`},
+		{"status", " In Progress ", `"In Progress"`},
+		{"summary", `say "hi" \o/`, `"say \"hi\" \\o/"`},
+		{"assignee", "currentUser()", "currentUser()"},
+		{"created", "startOfWeek(-1)", "startOfWeek(-1)"},
+		{"fixVersion", "latestReleasedVersion(ABC)", "latestReleasedVersion(ABC)"},
+		{"summary", "fix (later)", `"fix (later)"`},
+		{"summary", "f(x) + g(y)", `"f(x) + g(y)"`},
+		{"assignee", `membersOf("jira-users")`, `membersOf("jira-users")`},
+		{"summary", "print(x)", `"print(x)"`},
+		{"assignee", "currentUser(x OR project = SECRET)", `"currentUser(x OR project = SECRET)"`},
+		{"assignee", "me", "currentUser()"},
+		{"reporter", "ME", "currentUser()"},
+		{"watcher", "me", `"me"`},
+		{"assignee", "unassigned", "EMPTY"},
+		{"resolution", "Unresolved", "EMPTY"},
+		{"status", "unresolved", `"unresolved"`},
+	}
+	for _, tt := range tests {
+		if got := jqlOperand(tt.field, tt.in, known); got != tt.want {
+			t.Errorf("jqlOperand(%q, %q) = %s, want %s", tt.field, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestJQLFieldName(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"status", "status"},
+		{"cf[10105]", "cf[10105]"},
+		{"issue.property", "issue.property"},
+		{"Story Points", `"Story Points"`},
+		{`"Story Points"`, `"Story Points"`},
+	}
+	for _, tt := range tests {
+		if got := jqlFieldName(tt.in); got != tt.want {
+			t.Errorf("jqlFieldName(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// shrinkLevel bounds the length of text values and lists. 0 means unbounded.
+type shrinkLevel struct {
+	text  int // Characters per string
+	items int // Elements per list
+}
+
+// shrinkLevels are tried in order until a response fits: long texts such as
+// rendered descriptions go first, then lists get shorter step by step.
+var shrinkLevels = []shrinkLevel{
+	{text: 2000},
+	{text: 1000, items: 100},
+	{text: 1000, items: 50},
+	{text: 500, items: 20},
+	{text: 250, items: 10},
+	{text: 250, items: 5},
+	{text: 100, items: 3},
+	{text: 100, items: 1},
+}
+
+// responseLimitMiddleware truncates text results larger than limit bytes
+// and adds a note on what was left out and how to get it. JSON results stay
+// valid JSON: long strings and lists are shortened as in shrinkLevels.
+// Other text keeps its lines up to the limit. A limit of 0 turns this off.
+func responseLimitMiddleware(limit int) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, request)
+			if err != nil || result == nil || limit <= 0 {
+				return result, err
+			}
+			var notes []string
+			for i, content := range result.Content {
+				text, ok := content.(mcp.TextContent)
+				if !ok || len(text.Text) <= limit {
+					continue
+				}
+				size := len(text.Text)
+				var cut truncation
+				text.Text, cut = truncateText(text.Text, limit)
+				result.Content[i] = text
+				notes = append(notes, cut.note(size, limit, request.GetArguments()))
+				logging.FromContext(logging.WithToolName(ctx, request.Params.Name)).Info("response truncated", "bytes", size, "limit", limit)
+			}
+			for _, note := range notes {
+				result.Content = append(result.Content, mcp.NewTextContent(note))
+			}
+			return result, nil
+		}
+	}
+}
+
+// truncation records what was left out of a response. Paths name lists and
+// fields with [] for any element, e.g. issues[].fields.description.
+type truncation struct {
+	lists      map[string]*listCut
+	texts      map[string]int // Values shortened per path
+	textCap    int
+	raw        bool // Not JSON, cut as text
+	lines      int  // Lines kept of raw text
+	totalLines int
+	parsed     any // The full response, for pagination hints
+}
+
+type listCut struct {
+	lists   int // Lists shortened
+	kept    int // Elements kept of the first one
+	dropped int // Elements left out in all
+	total   int // Length of the first one
+}
+
+// limitText redacts and truncates tool output that is read outside a tool
+// call, by a resource read or a prompt. There is no room for a note on what
+// was left out, so it is only logged.
+func limitText(ctx context.Context, text string, limit int) string {
+	text = logging.RedactSecrets(ctx, text)
+	if size := len(text); limit > 0 && size > limit {
+		text, _ = truncateText(text, limit)
+		logging.FromContext(ctx).Info("response truncated", "bytes", size, "limit", limit)
+	}
+	return text
+}
+
+// truncateText shortens text to at most limit bytes.
+func truncateText(text string, limit int) (string, truncation) {
+	var v any
+	if err := json.Unmarshal([]byte(text), &v); err == nil {
+		for _, level := range shrinkLevels {
+			cut := truncation{lists: map[string]*listCut{}, texts: map[string]int{}, textCap: level.text, parsed: v}
+			shrunk := cut.shrink("", v, level)
+			if out, err := json.MarshalIndent(shrunk, "", "  "); err == nil && len(out) <= limit {
+				return string(out), cut
+			}
+		}
+	}
+	// Not JSON, or too large even at the last level: keep whole lines, such
+	// as the rows of a table, when there are any.
+	end := limit
+	for end > 0 && !utf8.RuneStart(text[end]) {
+		end--
+	}
+	if i := strings.LastIndexByte(text[:end], '\n'); i > 0 {
+		end = i + 1
+	}
+	return text[:end], truncation{raw: true, lines: strings.Count(text[:end], "\n"), totalLines: strings.Count(text, "\n") + 1}
+}
+
+// shrink returns a copy of v with strings and lists bounded by level.
+func (t *truncation) shrink(path string, v any, level shrinkLevel) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, inner := range v {
+			child := key
+			if path != "" {
+				child = path + "." + key
+			}
+			out[key] = t.shrink(child, inner, level)
+		}
+		return out
+	case []any:
+		items := v
+		if level.items > 0 && len(v) > level.items {
+			items = v[:level.items]
+			name := path
+			if name == "" {
+				name = "(top level)"
+			}
+			c := t.lists[name]
+			if c == nil {
+				c = &listCut{kept: level.items, total: len(v)}
+				t.lists[name] = c
+			}
+			c.lists++
+			c.dropped += len(v) - level.items
+		}
+		out := make([]any, 0, len(items))
+		for _, item := range items {
+			out = append(out, t.shrink(path+"[]", item, level))
+		}
+		return out
+	case string:
+		if level.text > 0 && utf8.RuneCountInString(v) > level.text {
+			t.texts[path]++
+			return string([]rune(v)[:level.text]) + "…"
+		}
+	}
+	return v
+}
+
+// note explains the truncation and suggests narrower calls based on the
+// arguments of the call and the shape of the response.
+func (t truncation) note(size, limit int, args map[string]any) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Response truncated: it was %s, over the limit of %s (MAX_RESPONSE_SIZE).", approxSize(size), approxSize(limit))
+	if t.raw {
+		fmt.Fprintf(&b, " Kept the first %d of %d lines.", t.lines, t.totalLines)
+	}
+	for _, path := range sortedKeys(t.lists) {
+		c := t.lists[path]
+		if c.lists == 1 {
+			fmt.Fprintf(&b, "\n- %s: kept %d of %d items", path, c.kept, c.total)
+		} else {
+			fmt.Fprintf(&b, "\n- %s: %d lists cut to %d items, %d items left out", path, c.lists, c.kept, c.dropped)
+		}
+	}
+	for _, path := range sortedKeys(t.texts) {
+		fmt.Fprintf(&b, "\n- %s: %d values cut to %d characters", path, t.texts[path], t.textCap)
+	}
+
+	var hints []string
+	if expand, ok := args["expand"]; ok && fmt.Sprint(expand) != "" {
+		hints = append(hints, fmt.Sprintf("drop expand=%v or expand less", expand))
+	}
+	if t.hasIssueFields() || args["fields"] != nil {
+		hints = append(hints, "ask only for the fields you need, e.g. fields=summary,status,assignee")
+	}
+	if next, ok := t.nextPage(args); ok {
+		hints = append(hints, next)
+	} else if _, ok := args["jql"]; ok && t.raw {
+		hints = append(hints, "page through the results with startAt and maxResults")
+	}
+	if _, ok := args["columns"]; ok || len(hints) == 0 {
+		hints = append(hints, "narrow the request, e.g. with a more specific query or fewer columns")
+	}
+	b.WriteString("\nTo get the rest: " + strings.Join(hints, "; ") + ".")
+	return b.String()
+}
+
+// hasIssueFields reports whether the response holds issues with a fields
+// object, whose size the fields parameter controls.
+func (t truncation) hasIssueFields() bool {
+	m, _ := t.parsed.(map[string]any)
+	if _, ok := m["fields"].(map[string]any); ok {
+		return true
+	}
+	issues, _ := m["issues"].([]any)
+	return len(issues) > 0
+}
+
+// nextPage suggests the paging arguments that continue after the kept
+// elements of a paginated list such as issues, worklogs or values.
+func (t truncation) nextPage(args map[string]any) (string, bool) {
+	m, _ := t.parsed.(map[string]any)
+	if _, paged := m["startAt"]; !paged {
+		return "", false
+	}
+	keys := make([]string, 0, len(t.lists))
+	for key := range t.lists {
+		if !strings.ContainsAny(key, ".[") {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return "", false
+	}
+	sort.Strings(keys)
+	c := t.lists[keys[0]]
+	startAt, _ := m["startAt"].(float64)
+	if s, ok := args["startAt"]; ok {
+		fmt.Sscan(fmt.Sprint(s), &startAt)
+	}
+	return fmt.Sprintf("page through %s with startAt=%d and maxResults=%d", keys[0], int(startAt)+c.kept, c.kept), true
+}
+
+// approxSize writes a byte count with its rough size in tokens.
+func approxSize(bytes int) string {
+	return fmt.Sprintf("%d bytes (about %d tokens)", bytes, bytes/config.BytesPerToken)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jira-7-6-1/mcp-server/logging"
+)
+
+func TestTruncateTextJSON(t *testing.T) {
+	issues := make([]any, 200)
+	for i := range issues {
+		issues[i] = map[string]any{
+			"key":    fmt.Sprintf("ABC-%d", i+1),
+			"fields": map[string]any{"summary": "Issue", "description": strings.Repeat("long text ", 300)},
+		}
+	}
+	tests := []struct {
+		name  string
+		value any
+		limit int
+	}{
+		{"long strings", map[string]any{"description": strings.Repeat("é", 5000)}, 4000},
+		{"long list", map[string]any{"total": 200, "issues": issues}, 20000},
+		{"long top-level list", issues, 5000},
+		{"tight", map[string]any{"issues": issues}, 800},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(data) <= tt.limit {
+				t.Fatalf("input of %d bytes already fits in %d", len(data), tt.limit)
+			}
+			got, cut := truncateText(string(data), tt.limit)
+			if len(got) > tt.limit {
+				t.Errorf("got %d bytes, limit %d", len(got), tt.limit)
+			}
+			if cut.raw || !json.Valid([]byte(got)) {
+				t.Errorf("result is not valid JSON:\n%s", got)
+			}
+		})
+	}
+}
+
+func TestTruncateTextRaw(t *testing.T) {
+	text := strings.Repeat("| ABC-1 | Issue |\n", 100)
+	got, cut := truncateText(text, 100)
+	if len(got) > 100 || !strings.HasSuffix(got, "\n") {
+		t.Errorf("got %q, want whole lines within 100 bytes", got)
+	}
+	if !cut.raw || cut.lines != strings.Count(got, "\n") {
+		t.Errorf("truncation = %+v, want raw with %d lines", cut, strings.Count(got, "\n"))
+	}
+}
+
+func TestLimitText(t *testing.T) {
+	ctx := logging.WithSecrets(context.Background(), "session-secret")
+	text := `{"issues": [` + strings.Repeat(`{"key": "ABC-1", "summary": "session-secret"}, `, 100) + `{}]}`
+
+	got := limitText(ctx, text, 1000)
+	if len(got) > 1000 {
+		t.Errorf("limitText returned %d bytes, want at most 1000", len(got))
+	}
+	if strings.Contains(got, "session-secret") {
+		t.Errorf("limitText(%q) kept the secret", got)
+	}
+	if !json.Valid([]byte(got)) {
+		t.Errorf("limitText(%q) is not JSON", got)
+	}
+	if got := limitText(ctx, "session-secret", 0); got != "[REDACTED]" {
+		t.Errorf("limitText without a limit = %q, want [REDACTED]", got)
+	}
+}
@@ -0,0 +1,100 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// Forwarder receives redacted log records in addition to stderr, e.g. to
+// pass them on to MCP clients.
+type Forwarder interface {
+	// Enabled reports whether any receiver wants records at level.
+	Enabled(level slog.Level) bool
+	// Forward delivers a record. ctx is the context the record was logged
+	// with, or the one given to FromContext. Forward must not log.
+	Forward(ctx context.Context, r slog.Record)
+}
+
+var (
+	forwarderMu sync.RWMutex
+	forwarder   Forwarder
+)
+
+// SetForwarder installs f as the receiver of every log record.
+func SetForwarder(f Forwarder) {
+	forwarderMu.Lock()
+	defer forwarderMu.Unlock()
+	forwarder = f
+}
+
+func currentForwarder() Forwarder {
+	forwarderMu.RLock()
+	defer forwarderMu.RUnlock()
+	return forwarder
+}
+
+// forwardingHandler writes records to next when its level allows and hands
+// them to the installed Forwarder. Attributes added with WithAttrs are
+// collected so that forwarded records carry them too.
+type forwardingHandler struct {
+	next  slog.Handler
+	attrs []slog.Attr
+	ctx   context.Context // Context of the logger, used when Handle gets none
+}
+
+func (h *forwardingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if h.next.Enabled(ctx, level) {
+		return true
+	}
+	f := currentForwarder()
+	return f != nil && f.Enabled(level)
+}
+
+func (h *forwardingHandler) Handle(ctx context.Context, r slog.Record) error {
+	if (ctx == nil || ctx == context.Background()) && h.ctx != nil {
+		ctx = h.ctx
+	}
+	if f := currentForwarder(); f != nil && f.Enabled(r.Level) {
+		out := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+		out.AddAttrs(h.attrs...)
+		r.Attrs(func(a slog.Attr) bool {
+			out.AddAttrs(a)
+			return true
+		})
+		f.Forward(ctx, out)
+	}
+	if !h.next.Enabled(ctx, r.Level) {
+		return nil
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *forwardingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &forwardingHandler{
+		next:  h.next.WithAttrs(attrs),
+		attrs: append(append([]slog.Attr{}, h.attrs...), attrs...),
+		ctx:   h.ctx,
+	}
+}
+
+func (h *forwardingHandler) WithGroup(name string) slog.Handler {
+	return &forwardingHandler{next: h.next.WithGroup(name), attrs: h.attrs, ctx: h.ctx}
+}
+
+// contextual is implemented by handlers that can remember the context of
+// the logger they belong to.
+type contextual interface {
+	withContext(ctx context.Context) slog.Handler
+}
+
+func (h *RedactingHandler) withContext(ctx context.Context) slog.Handler {
+	if c, ok := h.next.(contextual); ok {
+		return &RedactingHandler{next: c.withContext(ctx), ctx: ctx}
+	}
+	return &RedactingHandler{next: h.next, ctx: ctx}
+}
+
+func (h *forwardingHandler) withContext(ctx context.Context) slog.Handler {
+	return &forwardingHandler{next: h.next, attrs: h.attrs, ctx: ctx}
+}
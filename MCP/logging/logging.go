@@ -0,0 +1,100 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota
+	toolNameKey
+	secretsKey
+)
+
+// Setup installs the process-wide slog logger. Level is one of debug, info,
+// warn or error (default info); format is json or text (default json).
+// Records are written to stderr so they never interfere with STDIO transport,
+// and handed to the Forwarder installed with SetForwarder.
+func Setup(level, format string) *slog.Logger {
+	logger := slog.New(NewRedactingHandler(&forwardingHandler{next: newHandler(os.Stderr, level, format)}))
+	slog.SetDefault(logger)
+	return logger
+}
+
+func newHandler(w io.Writer, level, format string) slog.Handler {
+	opts := &slog.HandlerOptions{Level: ParseLevel(level)}
+	if strings.EqualFold(format, "text") {
+		return slog.NewTextHandler(w, opts)
+	}
+	return slog.NewJSONHandler(w, opts)
+}
+
+// ParseLevel maps a level name to a slog.Level, defaulting to info.
+func ParseLevel(level string) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// NewRequestID returns a short random identifier for correlating log lines
+// that belong to one tool call.
+func NewRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// WithRequestID stores the request ID in ctx.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestID returns the request ID stored in ctx, if any.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// WithToolName stores the name of the tool being called in ctx.
+func WithToolName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, toolNameKey, name)
+}
+
+// ToolName returns the tool name stored in ctx, if any.
+func ToolName(ctx context.Context) string {
+	name, _ := ctx.Value(toolNameKey).(string)
+	return name
+}
+
+// FromContext returns the default logger annotated with the request ID and
+// tool name carried by ctx. Records it logs are forwarded with ctx, so they
+// reach the client session that made the request.
+func FromContext(ctx context.Context) *slog.Logger {
+	logger := slog.Default()
+	if h, ok := logger.Handler().(contextual); ok {
+		logger = slog.New(h.withContext(ctx))
+	}
+	if id := RequestID(ctx); id != "" {
+		logger = logger.With("request_id", id)
+	}
+	if name := ToolName(ctx); name != "" {
+		logger = logger.With("tool", name)
+	}
+	return logger
+}
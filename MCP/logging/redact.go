@@ -0,0 +1,199 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	secretsMu sync.RWMutex
+	secrets   = map[string]struct{}{}
+
+	// Credentials that show up in headers, environment dumps, URLs or
+	// Jira error bodies even when the exact value was never registered.
+	secretPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)(authorization"?\s*[:=]\s*"?)(?:(?:Bearer|Basic)\s+)?[^\s",&}]+`),
+		regexp.MustCompile(`(?i)((?:bearer[_-]?token|api[_-]?key|basic[_-]?auth|password|token)"?\s*[:=]\s*"?)[^\s",&}]+`),
+		regexp.MustCompile(`(?i)(\bBearer\s+)[A-Za-z0-9._~+/=-]{8,}`),
+	}
+
+	// Words that name a credential at the end of a key, alone or written
+	// together with the word before, as in api_key.
+	sensitiveKeys = map[string]bool{
+		"authorization": true, "token": true, "apikey": true, "basicauth": true,
+		"password": true, "secret": true, "secretkey": true, "cookie": true,
+	}
+)
+
+// RegisterSecrets records credential values that must never appear in logs
+// or tool output, such as those of the server configuration. Empty and very
+// short values are ignored so they cannot mask ordinary text.
+func RegisterSecrets(values ...string) {
+	secretsMu.Lock()
+	defer secretsMu.Unlock()
+	for _, v := range values {
+		if len(v) >= 4 {
+			secrets[v] = struct{}{}
+		}
+	}
+}
+
+// WithSecrets stores credential values of one request, such as the headers
+// of an HTTP session, in ctx. Unlike RegisterSecrets they are forgotten with
+// ctx.
+func WithSecrets(ctx context.Context, values ...string) context.Context {
+	list := slices.Clip(contextSecrets(ctx))
+	for _, v := range values {
+		if len(v) >= 4 {
+			list = append(list, v)
+		}
+	}
+	return context.WithValue(ctx, secretsKey, list)
+}
+
+func contextSecrets(ctx context.Context) []string {
+	if ctx == nil {
+		return nil
+	}
+	list, _ := ctx.Value(secretsKey).([]string)
+	return list
+}
+
+// RedactSecrets masks the registered secrets and those stored in ctx. It
+// leaves other text alone, so it suits tool output that carries Jira data.
+func RedactSecrets(ctx context.Context, s string) string {
+	if s == "" {
+		return s
+	}
+	secretsMu.RLock()
+	for v := range secrets {
+		s = strings.ReplaceAll(s, v, redacted)
+	}
+	secretsMu.RUnlock()
+	for _, v := range contextSecrets(ctx) {
+		s = strings.ReplaceAll(s, v, redacted)
+	}
+	return s
+}
+
+// Redact masks secrets like RedactSecrets and, for log output and recorded
+// requests, anything that looks like a credential in s.
+func Redact(ctx context.Context, s string) string {
+	s = RedactSecrets(ctx, s)
+	for _, re := range secretPatterns {
+		s = re.ReplaceAllString(s, "${1}"+redacted)
+	}
+	return s
+}
+
+// IsSensitiveKey reports whether a header or attribute name carries
+// credentials, e.g. Authorization, X-Api-Key or accessToken. Only the last
+// words of the name count, so max_tokens and tokenCount are not sensitive.
+func IsSensitiveKey(key string) bool {
+	words := keyWords(key)
+	n := len(words)
+	if n == 0 {
+		return false
+	}
+	return sensitiveKeys[words[n-1]] || n > 1 && sensitiveKeys[words[n-2]+words[n-1]]
+}
+
+// keyWords splits a name into lower-case words at punctuation and case
+// changes, e.g. "X-APIKey" into x, api and key.
+func keyWords(key string) []string {
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = word[:0]
+		}
+	}
+	runes := []rune(key)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || unicode.IsUpper(prev) && nextLower {
+				flush()
+			}
+		}
+		word = append(word, unicode.ToLower(r))
+	}
+	flush()
+	return words
+}
+
+// RedactingHandler wraps a slog.Handler and redacts the message and every
+// string attribute before the record is written.
+type RedactingHandler struct {
+	next slog.Handler
+	ctx  context.Context // Of the logger, for its secrets
+}
+
+// NewRedactingHandler returns a handler that redacts records passed to next.
+func NewRedactingHandler(next slog.Handler) *RedactingHandler {
+	return &RedactingHandler{next: next}
+}
+
+func (h *RedactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *RedactingHandler) Handle(ctx context.Context, r slog.Record) error {
+	if h.ctx != nil {
+		ctx = h.ctx
+	}
+	out := slog.NewRecord(r.Time, r.Level, Redact(ctx, r.Message), r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(redactAttr(ctx, a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+func (h *RedactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clean := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		clean[i] = redactAttr(h.ctx, a)
+	}
+	return &RedactingHandler{next: h.next.WithAttrs(clean), ctx: h.ctx}
+}
+
+func (h *RedactingHandler) WithGroup(name string) slog.Handler {
+	return &RedactingHandler{next: h.next.WithGroup(name), ctx: h.ctx}
+}
+
+func redactAttr(ctx context.Context, a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+	if IsSensitiveKey(a.Key) {
+		return slog.String(a.Key, redacted)
+	}
+	switch a.Value.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, Redact(ctx, a.Value.String()))
+	case slog.KindGroup:
+		group := a.Value.Group()
+		clean := make([]any, len(group))
+		for i, g := range group {
+			clean[i] = redactAttr(ctx, g)
+		}
+		return slog.Group(a.Key, clean...)
+	case slog.KindAny:
+		if err, ok := a.Value.Any().(error); ok {
+			return slog.String(a.Key, Redact(ctx, err.Error()))
+		}
+	}
+	return a
+}
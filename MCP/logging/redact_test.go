@@ -0,0 +1,153 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	RegisterSecrets("s3cr3t-value", "abc")
+	tests := []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"nothing to hide", "nothing to hide"},
+		{"token s3cr3t-value in text", "token [REDACTED] in text"},
+		{"abc is too short to register", "abc is too short to register"},
+		{"Authorization: Bearer eyJhbGciOi.x.y", "Authorization: [REDACTED]"},
+		{`{"authorization":"Basic dXNlcjpwYXNz"}`, `{"authorization":"[REDACTED]"}`},
+		{"api_key=12345678&x=1", "api_key=[REDACTED]&x=1"},
+		{`"password": "hunter22"`, `"password": "[REDACTED]"`},
+		{"sent Bearer abcdefgh12345", "sent Bearer [REDACTED]"},
+	}
+	for _, tt := range tests {
+		if got := Redact(context.Background(), tt.in); got != tt.want {
+			t.Errorf("Redact(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRedactSecrets(t *testing.T) {
+	RegisterSecrets("global-secret")
+	ctx := WithSecrets(context.Background(), "session-secret", "abc")
+	tests := []struct {
+		ctx      context.Context
+		in, want string
+	}{
+		{ctx, "global-secret and session-secret", "[REDACTED] and [REDACTED]"},
+		{ctx, "abc is too short to register", "abc is too short to register"},
+		{ctx, `"password": "hunter22"`, `"password": "hunter22"`},
+		{context.Background(), "session-secret", "session-secret"},
+		{WithSecrets(ctx, "second-secret"), "session-secret, second-secret", "[REDACTED], [REDACTED]"},
+	}
+	for _, tt := range tests {
+		if got := RedactSecrets(tt.ctx, tt.in); got != tt.want {
+			t.Errorf("RedactSecrets(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestIsSensitiveKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"Authorization", true},
+		{"Proxy-Authorization", true},
+		{"X-Api-Key", true},
+		{"APIKey", true},
+		{"apikey", true},
+		{"BASIC_AUTH", true},
+		{"accessToken", true},
+		{"X-Auth-Token", true},
+		{"Set-Cookie", true},
+		{"aws_secret_key", true},
+		{"BEARER_TOKEN", true},
+		{"password", true},
+		{"Cookie", true},
+		{"client_secret", true},
+		{"issue", false},
+		{"Content-Type", false},
+		{"max_tokens", false},
+		{"tokenCount", false},
+		{"passwordPolicy", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := IsSensitiveKey(tt.key); got != tt.want {
+			t.Errorf("IsSensitiveKey(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestRedactingHandler(t *testing.T) {
+	RegisterSecrets("handler-secret")
+	tests := []struct {
+		name string
+		log  func(*slog.Logger)
+		want []string
+		hide string
+	}{
+		{
+			name: "message",
+			log:  func(l *slog.Logger) { l.Info("using handler-secret") },
+			want: []string{`msg="using [REDACTED]"`},
+			hide: "handler-secret",
+		},
+		{
+			name: "sensitive key",
+			log:  func(l *slog.Logger) { l.Info("request", "Authorization", "anything") },
+			want: []string{"Authorization=[REDACTED]"},
+			hide: "anything",
+		},
+		{
+			name: "string value",
+			log:  func(l *slog.Logger) { l.Info("request", "url", "https://jira/?x=handler-secret") },
+			want: []string{`url="https://jira/?x=[REDACTED]"`},
+			hide: "handler-secret",
+		},
+		{
+			name: "error",
+			log:  func(l *slog.Logger) { l.Error("failed", "error", errors.New("bad handler-secret")) },
+			want: []string{`error="bad [REDACTED]"`},
+			hide: "handler-secret",
+		},
+		{
+			name: "group",
+			log:  func(l *slog.Logger) { l.Info("request", slog.Group("headers", "Cookie", "id=1")) },
+			want: []string{"headers.Cookie=[REDACTED]"},
+			hide: "id=1",
+		},
+		{
+			name: "context secret",
+			log: func(l *slog.Logger) {
+				l.InfoContext(WithSecrets(context.Background(), "session-secret"), "request", "url", "https://jira/?x=session-secret")
+			},
+			want: []string{`url="https://jira/?x=[REDACTED]"`},
+			hide: "session-secret",
+		},
+		{
+			name: "with attrs",
+			log:  func(l *slog.Logger) { l.With("token", "abcdef").Info("request") },
+			want: []string{"token=[REDACTED]"},
+			hide: "abcdef",
+		},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		tt.log(slog.New(NewRedactingHandler(slog.NewTextHandler(&buf, nil))))
+		out := buf.String()
+		for _, want := range tt.want {
+			if !strings.Contains(out, want) {
+				t.Errorf("%s: output %q does not contain %q", tt.name, out, want)
+			}
+		}
+		if strings.Contains(out, tt.hide) {
+			t.Errorf("%s: output %q contains %q", tt.name, out, tt.hide)
+		}
+	}
+}
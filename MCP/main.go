@@ -2,7 +2,7 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"net"
 	"net/http"
 	"os"
@@ -10,14 +10,21 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/mark3labs/mcp-go/server"
 	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/mark3labs/mcp-go/server"
 )
 
 func main() {
 	cfg, err := config.LoadAPIConfig()
 	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
+		fatal("Failed to load config", "error", err)
+	}
+	logging.Setup(cfg.LogLevel, cfg.LogFormat)
+	logging.RegisterSecrets(cfg.BearerToken, cfg.APIKey, cfg.BasicAuth)
+	logging.SetForwarder(clientLogs)
+	for _, problem := range cfg.Problems {
+		slog.Warn("Invalid configuration value ignored", "problem", problem)
 	}
 
 	// Check transport environment variable (both uppercase and lowercase)
@@ -32,7 +39,7 @@ func main() {
 	if transport == "http" || transport == "HTTP" || transport == "https" || transport == "HTTPS" {
 		port := cfg.Port
 		if port == "" {
-			log.Fatalf("PORT environment variable is required for HTTP/HTTPS mode. Please set PORT environment variable.")
+			fatal("PORT environment variable is required for HTTP/HTTPS mode. Please set PORT environment variable.")
 		}
 
 		// Determine if HTTPS mode and normalize transport
@@ -43,7 +50,7 @@ func main() {
 			transport = "HTTP"
 		}
 		
-		log.Printf("Running in %s mode on port %s", transport, port)
+		slog.Info("Running in HTTP mode", "transport", transport, "port", port)
 
 		mux := http.NewServeMux()
 		mux.HandleFunc("/mcp", func(w http.ResponseWriter, r *http.Request) {
@@ -53,19 +60,39 @@ func main() {
 				BearerToken: r.Header.Get("BEARER_TOKEN"),
 				APIKey:      r.Header.Get("API_KEY"),
 				BasicAuth:   r.Header.Get("BASIC_AUTH"),
+				// A client may opt into read-only mode but never out of it
+				ReadOnly:   cfg.ReadOnly || config.ParseBool(r.Header.Get("READ_ONLY")),
+				ToolGroups: cfg.ToolGroups,
+				AllowTools: cfg.AllowTools,
+				DenyTools:  cfg.DenyTools,
+				LazyTools:  cfg.LazyTools || config.ParseBool(r.Header.Get("LAZY_TOOLS")),
+				// Confirmation is a server policy that clients cannot turn off
+				ConfirmDestructive: cfg.ConfirmDestructive,
+				// Like read-only mode, a client may opt into dry runs but never out
+				DryRun: cfg.DryRun || config.ParseBool(r.Header.Get("DRY_RUN")),
+				// A client may lower the response limit but not lift it
+				MaxResponseBytes: cfg.MaxResponseBytes,
+			}
+			if size, ok := config.ParseSize(r.Header.Get("MAX_RESPONSE_SIZE")); ok && size > 0 && (apiCfg.MaxResponseBytes == 0 || size < apiCfg.MaxResponseBytes) {
+				apiCfg.MaxResponseBytes = size
+			}
+			// Each HTTP session may pick its own tool groups
+			if groups := r.Header.Get("TOOL_GROUPS"); groups != "" {
+				apiCfg.ToolGroups = config.ParseList(groups)
 			}
 
 			if apiCfg.BaseURL == "" {
 				http.Error(w, "Missing API_BASE_URL header", http.StatusBadRequest)
 				return
 			}
-
-			log.Printf("Incoming HTTP request - BaseURL: %s", apiCfg.BaseURL)
+			slog.Info("Incoming HTTP request", "base_url", apiCfg.BaseURL, "method", r.Method, "remote_addr", r.RemoteAddr)
 
 			// Create MCP server for this request
-			mcpSrv := createMCPServer(apiCfg, transport)
+			mcpSrv := createMCPServer(r.Context(), apiCfg, transport)
 			handler := server.NewStreamableHTTPServer(mcpSrv, server.WithHTTPContextFunc(
 				func(ctx context.Context, req *http.Request) context.Context {
+					// Redacted from this session's logs and results, then forgotten
+					ctx = logging.WithSecrets(ctx, apiCfg.BearerToken, apiCfg.APIKey, apiCfg.BasicAuth)
 					return context.WithValue(ctx, "apiConfig", apiCfg)
 				},
 			))
@@ -88,58 +115,130 @@ func main() {
 				keyFile := os.Getenv("KEY_FILE")
 				
 				if certFile == "" || keyFile == "" {
-					log.Fatalf("CERT_FILE and KEY_FILE environment variables are required for HTTPS mode")
+					fatal("CERT_FILE and KEY_FILE environment variables are required for HTTPS mode")
 				}
 				
-				log.Printf("Starting HTTPS server on %s", addr)
+				slog.Info("Starting HTTPS server", "addr", addr)
 				if err := httpServer.ListenAndServeTLS(certFile, keyFile); err != http.ErrServerClosed {
-					log.Fatalf("HTTPS server error: %v", err)
+					fatal("HTTPS server error", "error", err)
 				}
 			} else {
-				log.Printf("Starting HTTP server on %s", addr)
+				slog.Info("Starting HTTP server", "addr", addr)
 				if err := httpServer.ListenAndServe(); err != http.ErrServerClosed {
-					log.Fatalf("HTTP server error: %v", err)
+					fatal("HTTP server error", "error", err)
 				}
 			}
 		}()
 
 		<-sigChan
-		log.Println("Shutdown signal received")
+		slog.Info("Shutdown signal received")
 
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 		if err := httpServer.Shutdown(ctx); err != nil {
-			log.Printf("Shutdown error: %v", err)
+			slog.Error("Shutdown error", "error", err)
 		} else {
-			log.Println("HTTP server shutdown complete")
+			slog.Info("HTTP server shutdown complete")
 		}
 		return
 	}
 
 	// STDIO Mode - default when no transport or transport is "stdio"
-	log.Println("Running in STDIO mode")
-	mcp := createMCPServer(cfg, "STDIO")
+	slog.Info("Running in STDIO mode")
+	// Cancelled on shutdown, or when the session ends, to stop polling
+	// subscribed issues
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	mcp := createMCPServer(ctx, cfg, "STDIO")
 	go func() {
+		defer stop()
 		if err := server.ServeStdio(mcp); err != nil {
-			log.Fatalf("STDIO error: %v", err)
+			fatal("STDIO error", "error", err)
 		}
 	}()
 	<-sigChan
-	log.Println("Received shutdown signal. Exiting STDIO mode.")
+	slog.Info("Received shutdown signal. Exiting STDIO mode.")
 }
 
-func createMCPServer(cfg *config.APIConfig, mode string) *server.MCPServer {
-	mcp := server.NewMCPServer("JIRA 7.6.1", "1.0.0",
+func createMCPServer(ctx context.Context, cfg *config.APIConfig, mode string) *server.MCPServer {
+	completer := newCompleter(cfg)
+	opts := []server.ServerOption{
 		server.WithToolCapabilities(true),
+		server.WithPromptCapabilities(false),
+		server.WithCompletions(),
+		server.WithPromptCompletionProvider(completer),
+		server.WithResourceCompletionProvider(completer),
+		server.WithLogging(),
 		server.WithRecovery(),
-	)
+		// Outermost, so that results are redacted before they are cut
+		server.WithToolHandlerMiddleware(responseLimitMiddleware(cfg.MaxResponseBytes)),
+		server.WithToolHandlerMiddleware(loggingMiddleware),
+		server.WithInstructions(serverInstructions(cfg)),
+	}
+	if cfg.ReadOnly {
+		opts = append(opts, server.WithToolHandlerMiddleware(readOnlyMiddleware))
+	}
+	// HTTP/HTTPS mode builds a new server per request, so only STDIO sessions
+	// live long enough to receive resource update notifications and logs
+	// written outside a request.
+	hooks := &server.Hooks{}
+	clientLogs.addHooks(hooks, mode == "STDIO")
+	var watch *watcher
+	if mode == "STDIO" {
+		watch = newWatcher(ctx, cfg)
+		watch.addHooks(hooks)
+		opts = append(opts, server.WithResourceCapabilities(true, false))
+	} else {
+		opts = append(opts, server.WithResourceCapabilities(false, false))
+	}
+	hooks.AddAfterListTools(hideLegacyAliases)
+	opts = append(opts, server.WithHooks(hooks))
+	mcp := server.NewMCPServer("JIRA 7.6.1", "1.0.0", opts...)
+	if watch != nil {
+		watch.server = mcp
+	}
 
 	tools := GetAll(cfg)
-	log.Printf("Loaded %d tools for %s mode", len(tools), mode)
+	if cfg.LazyTools {
+		tools = GetMeta(tools)
+	} else {
+		tools = append(tools, legacyAliases(tools)...)
+		tools = append(tools, GetDocs(tools))
+	}
+	slog.Debug("Loaded tools", "count", len(tools), "mode", mode, "read_only", cfg.ReadOnly, "lazy", cfg.LazyTools)
 
 	for _, tool := range tools {
 		mcp.AddTool(tool.Definition, tool.Handler)
 	}
+	addResources(mcp, cfg)
+	addPrompts(mcp, cfg)
 
 	return mcp
-}
\ No newline at end of file
+}
+
+// serverInstructions describes the server mode to clients in the initialize response.
+func serverInstructions(cfg *config.APIConfig) string {
+	instructions := "Jira 7.6.1 server in read-write mode. Tools may create, update and delete Jira data."
+	if cfg.ReadOnly {
+		instructions = "Jira 7.6.1 server in READ-ONLY mode. Only tools that read data are available; " +
+			"any request that would create, update or delete Jira data is rejected."
+	} else if cfg.ConfirmDestructive {
+		instructions += " Deleting projects, issues, versions, components, filters, schemes, groups or users and changing the base URL " +
+			"need the user's confirmation. If a call answers with a confirmationCode, show the message to the user and " +
+			"repeat the call with the code only after they agree."
+	}
+	if cfg.DryRun && !cfg.ReadOnly {
+		instructions += " DRY-RUN mode is on: tools that write return the request they would send instead of changing Jira."
+	}
+	if cfg.LazyTools {
+		instructions += " Jira operations are not listed as tools: find them with search_jira_operations, " +
+			"inspect their parameters with describe_jira_operation and call them with invoke_jira_operation."
+	}
+	return instructions
+}
+
+// fatal logs msg at error level and terminates the process.
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
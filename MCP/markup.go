@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/jira-7-6-1/mcp-server/wiki"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// markdownReadTools are globs of the tools that can return descriptions,
+// comments and worklog comments as Markdown.
+var markdownReadTools = []string{
+	"get_api_2_issue_issueIdOrKey",
+	"get_api_2_issue_issueIdOrKey_comment",
+	"get_api_2_issue_issueIdOrKey_comment_id",
+	"get_api_2_issue_issueIdOrKey_worklog*",
+	"post_api_2_worklog_list",
+	"*_api_2_search",
+	"jira_search",
+	"jira_issue_timeline",
+}
+
+// withMarkup declares the markup parameter of tools that write rich text.
+func withMarkup() mcp.ToolOption {
+	return mcp.WithString("markup", mcp.Description("markup of the description and comment texts: markdown (default), converted to Jira wiki markup, or wiki to send them as they are"), mcp.Enum("markdown", "wiki"))
+}
+
+// toWiki returns text in wiki markup, converting it from Markdown unless the
+// request says it is wiki markup already.
+func toWiki(request mcp.CallToolRequest, text string) string {
+	if request.GetString("markup", "markdown") == "wiki" {
+		return text
+	}
+	return wiki.FromMarkdown(text)
+}
+
+// isRichText reports whether a field holds wiki markup: the description,
+// the environment and multi-line text custom fields.
+func isRichText(f metaField) bool {
+	return isRichTextSchema(f.Schema)
+}
+
+func isRichTextSchema(s fieldSchema) bool {
+	return s.System == "description" || s.System == "environment" || strings.HasSuffix(s.Custom, ":textarea")
+}
+
+// richTextToWiki converts the string values of the rich text fields among
+// fields, keyed by field id.
+func richTextToWiki(request mcp.CallToolRequest, fields map[string]any, meta map[string]metaField) {
+	for id, value := range fields {
+		if s, ok := value.(string); ok && isRichText(meta[id]) {
+			fields[id] = toWiki(request, s)
+		}
+	}
+}
+
+// markupTools adds a markup parameter to markdownReadTools. With
+// markup=markdown the rich text values of the result are converted from
+// wiki markup after the tool has run.
+func markupTools(tools []models.Tool, cfg *config.APIConfig) []models.Tool {
+	for i := range tools {
+		if !matchAny(markdownReadTools, toolNames(tools[i].Definition.Name)...) {
+			continue
+		}
+		mcp.WithString("markup", mcp.Description("markup of descriptions, comments and worklog comments in the result: wiki (default, as Jira stores them) or markdown"), mcp.Enum("wiki", "markdown"))(&tools[i].Definition)
+		tools[i].Handler = markupHandler(cfg, tools[i].Handler)
+	}
+	return tools
+}
+
+func markupHandler(cfg *config.APIConfig, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		markdown := request.GetString("markup", "wiki") == "markdown"
+		request.Params.Arguments = withoutKey(request.GetArguments(), "markup")
+		result, err := next(ctx, request)
+		if err != nil || !markdown || result.IsError || len(result.Content) == 0 {
+			return result, err
+		}
+		text, ok := result.Content[0].(mcp.TextContent)
+		if !ok {
+			return result, nil
+		}
+		var response any
+		if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+			return result, nil
+		}
+		pretty, err := json.MarshalIndent(richTextFields(ctx, cfg).toMarkdown(response), "", "  ")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+		}
+		return mcp.NewToolResultText(string(pretty)), nil
+	}
+}
+
+// richText holds the ids and display names of the issue fields that hold
+// wiki markup.
+type richText map[string]bool
+
+// richTextFields looks up the multi-line text custom fields. Without the
+// field list, only the description and environment are converted.
+func richTextFields(ctx context.Context, cfg *config.APIConfig) richText {
+	rich := richText{"description": true, "environment": true}
+	var fields []jiraField
+	if err := cachedGet(ctx, cfg, "/api/2/field", &fields); err != nil {
+		logging.FromContext(ctx).Warn("field list unavailable, converting only description and environment", "error", err)
+		return rich
+	}
+	for _, f := range fields {
+		if isRichTextSchema(f.Schema) {
+			rich[f.ID], rich[f.Name] = true, true
+		}
+	}
+	return rich
+}
+
+// toMarkdown converts the rich text in v: the rich text fields of issues,
+// the body of comments and the comment of worklogs. Descriptions elsewhere,
+// such as those of issue types, statuses and projects, are plain text.
+// Rendered HTML under renderedFields is left alone.
+func (r richText) toMarkdown(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		if isComment(v) {
+			v["body"] = wiki.ToMarkdown(v["body"].(string))
+		}
+		if isWorklog(v) {
+			v["comment"] = wiki.ToMarkdown(v["comment"].(string))
+		}
+		for key, inner := range v {
+			switch inner := inner.(type) {
+			case map[string]any:
+				if key == "fields" {
+					r.fieldsToMarkdown(inner)
+				} else if key != "renderedFields" {
+					r.toMarkdown(inner)
+				}
+			case []any:
+				if key == "issues" {
+					r.issuesToMarkdown(inner)
+				} else {
+					r.toMarkdown(inner)
+				}
+			}
+		}
+	case []any:
+		for _, inner := range v {
+			r.toMarkdown(inner)
+		}
+	}
+	return v
+}
+
+// issuesToMarkdown converts a list of issues, either as Jira returns them,
+// with their fields under fields, or as jira_search rows keyed by field.
+func (r richText) issuesToMarkdown(issues []any) {
+	for _, issue := range issues {
+		m, ok := issue.(map[string]any)
+		if _, nested := m["fields"]; ok && !nested {
+			r.fieldsToMarkdown(m)
+		} else {
+			r.toMarkdown(issue)
+		}
+	}
+}
+
+func (r richText) fieldsToMarkdown(fields map[string]any) {
+	for key, value := range fields {
+		if s, ok := value.(string); ok && r[key] {
+			fields[key] = wiki.ToMarkdown(s)
+		} else {
+			r.toMarkdown(value)
+		}
+	}
+}
+
+// isComment reports whether m is a comment, or a comment event of
+// jira_issue_timeline, both of which have an id and a body.
+func isComment(m map[string]any) bool {
+	_, body := m["body"].(string)
+	_, id := m["id"]
+	return body && id
+}
+
+// isWorklog reports whether m is a worklog, or a worklog event of
+// jira_issue_timeline.
+func isWorklog(m map[string]any) bool {
+	_, comment := m["comment"].(string)
+	_, spent := m["timeSpent"]
+	return comment && spent
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jira-7-6-1/mcp-server/client"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// loggingMiddleware tags every tool call with a request ID, logs its outcome
+// and duration, and redacts credentials from the result before it reaches
+// the client.
+func loggingMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = logging.WithRequestID(ctx, logging.NewRequestID())
+		ctx = logging.WithToolName(ctx, request.Params.Name)
+		logger := logging.FromContext(ctx)
+		logger.Debug("tool call started")
+
+		start := time.Now()
+		result, err := next(ctx, request)
+		duration := time.Since(start).Milliseconds()
+
+		switch {
+		case err != nil:
+			logger.Error("tool call failed", "duration_ms", duration, "error", err)
+		case result != nil && result.IsError:
+			logger.Warn("tool call returned error", "duration_ms", duration, "status", "error")
+		default:
+			logger.Info("tool call completed", "duration_ms", duration, "status", "ok")
+		}
+
+		redactResult(ctx, result)
+		if err != nil {
+			err = errors.New(logging.RedactSecrets(ctx, err.Error()))
+		}
+		return result, err
+	}
+}
+
+// readOnlyMiddleware marks every tool call so the request layer rejects
+// write methods, in addition to the registry only exposing GET tools.
+func readOnlyMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return next(client.WithReadOnly(ctx), request)
+	}
+}
+
+// redactResult masks credential values in result. Unlike log records, results
+// are not searched for credential-like text, which Jira data may contain.
+func redactResult(ctx context.Context, result *mcp.CallToolResult) {
+	if result == nil {
+		return
+	}
+	for i, content := range result.Content {
+		if text, ok := content.(mcp.TextContent); ok {
+			text.Text = logging.RedactSecrets(ctx, text.Text)
+			result.Content[i] = text
+		}
+	}
+}
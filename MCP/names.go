@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/jira-7-6-1/mcp-server/operations"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// friendlyNames renames generated tools to their stable friendly names,
+// e.g. get_api_2_issue_issueIdOrKey becomes jira_get_issue.
+func friendlyNames(tools []models.Tool) []models.Tool {
+	for i := range tools {
+		if op, ok := operations.ByLegacy(tools[i].Definition.Name); ok {
+			tools[i].Definition.Name = op.Name
+		}
+	}
+	return tools
+}
+
+// legacyAliases returns a deprecated copy of each renamed tool under its
+// generated name so existing clients keep working. Aliases are callable but
+// hidden from tools/list by hideLegacyAliases.
+func legacyAliases(tools []models.Tool) []models.Tool {
+	aliases := make([]models.Tool, 0, len(tools))
+	for _, tool := range tools {
+		op, ok := operations.ByName(tool.Definition.Name)
+		if !ok {
+			continue
+		}
+		alias := tool
+		alias.Definition.Name = op.Legacy
+		alias.Definition.Description = fmt.Sprintf("Deprecated alias of %s. %s", op.Name, tool.Definition.Description)
+		alias.Handler = deprecatedHandler(op, tool.Handler)
+		aliases = append(aliases, alias)
+	}
+	return aliases
+}
+
+func deprecatedHandler(op operations.Operation, next func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		logging.FromContext(ctx).Warn("deprecated tool alias called", "alias", op.Legacy, "replacement", op.Name)
+		return next(ctx, request)
+	}
+}
+
+// hideLegacyAliases drops deprecated alias names from tools/list. It runs as
+// an after-list hook rather than a tool filter, since the server also applies
+// tool filters to tools/call, which would make the aliases uncallable.
+func hideLegacyAliases(ctx context.Context, id any, message *mcp.ListToolsRequest, result *mcp.ListToolsResult) {
+	visible := make([]mcp.Tool, 0, len(result.Tools))
+	for _, tool := range result.Tools {
+		if _, ok := operations.ByLegacy(tool.Name); !ok {
+			visible = append(visible, tool)
+		}
+	}
+	result.Tools = visible
+}
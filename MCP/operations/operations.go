@@ -0,0 +1,57 @@
+package operations
+
+// Operation ties a generated Jira tool to its friendly name and to the
+// swagger operation it calls.
+type Operation struct {
+	Name        string // Friendly tool name, e.g. jira_get_issue
+	Legacy      string // Generated tool name, kept as a deprecated alias
+	OperationID string // Swagger operationId; empty when the spec defines none
+	Method      string // HTTP method
+	Path        string // Swagger path relative to the REST base URL
+}
+
+var (
+	byName        = map[string]Operation{}
+	byLegacy      = map[string]Operation{}
+	byOperationID = map[string]Operation{}
+)
+
+func init() {
+	for _, op := range All {
+		byName[op.Name] = op
+		byLegacy[op.Legacy] = op
+		if op.OperationID != "" {
+			byOperationID[op.OperationID] = op
+		}
+	}
+}
+
+// ByName returns the operation registered under a friendly tool name.
+func ByName(name string) (Operation, bool) {
+	op, ok := byName[name]
+	return op, ok
+}
+
+// ByLegacy returns the operation for a generated (deprecated) tool name.
+func ByLegacy(name string) (Operation, bool) {
+	op, ok := byLegacy[name]
+	return op, ok
+}
+
+// ByOperationID returns the operation with the given swagger operationId.
+func ByOperationID(id string) (Operation, bool) {
+	op, ok := byOperationID[id]
+	return op, ok
+}
+
+// Resolve finds an operation by friendly name, legacy name or swagger
+// operationId, in that order.
+func Resolve(name string) (Operation, bool) {
+	if op, ok := ByName(name); ok {
+		return op, true
+	}
+	if op, ok := ByLegacy(name); ok {
+		return op, true
+	}
+	return ByOperationID(name)
+}
@@ -0,0 +1,331 @@
+package operations
+
+// All lists every generated Jira tool with its stable, human-friendly name.
+// Friendly names are part of the public tool surface: never rename an entry,
+// only add new ones. Legacy names stay registered as deprecated aliases.
+var All = []Operation{
+	{Name: "jira_delete_attachment", Legacy: "delete_api_2_attachment_id", OperationID: "removeAttachment", Method: "DELETE", Path: "/api/2/attachment/{id}"},
+	{Name: "jira_delete_comment_property", Legacy: "delete_api_2_comment_commentId_properties_propertyKey", OperationID: "", Method: "DELETE", Path: "/api/2/comment/{commentId}/properties/{propertyKey}"},
+	{Name: "jira_delete_component", Legacy: "delete_api_2_component_id", OperationID: "", Method: "DELETE", Path: "/api/2/component/{id}"},
+	{Name: "jira_delete_dashboard_item_property", Legacy: "delete_api_2_dashboard_dashboardId_items_itemId_properties_propertyKey", OperationID: "", Method: "DELETE", Path: "/api/2/dashboard/{dashboardId}/items/{itemId}/properties/{propertyKey}"},
+	{Name: "jira_delete_filter", Legacy: "delete_api_2_filter_id", OperationID: "deleteFilter", Method: "DELETE", Path: "/api/2/filter/{id}"},
+	{Name: "jira_reset_filter_columns", Legacy: "delete_api_2_filter_id_columns", OperationID: "", Method: "DELETE", Path: "/api/2/filter/{id}/columns"},
+	{Name: "jira_delete_filter_share_permission", Legacy: "delete_api_2_filter_id_permission_permission-id", OperationID: "deleteSharePermission", Method: "DELETE", Path: "/api/2/filter/{id}/permission/{permission-id}"},
+	{Name: "jira_delete_group", Legacy: "delete_api_2_group", OperationID: "removeGroup", Method: "DELETE", Path: "/api/2/group"},
+	{Name: "jira_remove_user_from_group", Legacy: "delete_api_2_group_user", OperationID: "removeUserFromGroup", Method: "DELETE", Path: "/api/2/group/user"},
+	{Name: "jira_delete_issue_link_type", Legacy: "delete_api_2_issueLinkType_issueLinkTypeId", OperationID: "deleteIssueLinkType", Method: "DELETE", Path: "/api/2/issueLinkType/{issueLinkTypeId}"},
+	{Name: "jira_delete_issue_link", Legacy: "delete_api_2_issueLink_linkId", OperationID: "deleteIssueLink", Method: "DELETE", Path: "/api/2/issueLink/{linkId}"},
+	{Name: "jira_delete_issue", Legacy: "delete_api_2_issue_issueIdOrKey", OperationID: "deleteIssue", Method: "DELETE", Path: "/api/2/issue/{issueIdOrKey}"},
+	{Name: "jira_delete_issue_comment", Legacy: "delete_api_2_issue_issueIdOrKey_comment_id", OperationID: "deleteComment", Method: "DELETE", Path: "/api/2/issue/{issueIdOrKey}/comment/{id}"},
+	{Name: "jira_delete_issue_property", Legacy: "delete_api_2_issue_issueIdOrKey_properties_propertyKey", OperationID: "", Method: "DELETE", Path: "/api/2/issue/{issueIdOrKey}/properties/{propertyKey}"},
+	{Name: "jira_delete_remote_issue_link_by_global_id", Legacy: "delete_api_2_issue_issueIdOrKey_remotelink", OperationID: "deleteRemoteIssueLinkByGlobalId", Method: "DELETE", Path: "/api/2/issue/{issueIdOrKey}/remotelink"},
+	{Name: "jira_delete_remote_issue_link", Legacy: "delete_api_2_issue_issueIdOrKey_remotelink_linkId", OperationID: "deleteRemoteIssueLinkById", Method: "DELETE", Path: "/api/2/issue/{issueIdOrKey}/remotelink/{linkId}"},
+	{Name: "jira_remove_issue_vote", Legacy: "delete_api_2_issue_issueIdOrKey_votes", OperationID: "removeVote", Method: "DELETE", Path: "/api/2/issue/{issueIdOrKey}/votes"},
+	{Name: "jira_remove_issue_watcher", Legacy: "delete_api_2_issue_issueIdOrKey_watchers", OperationID: "removeWatcher", Method: "DELETE", Path: "/api/2/issue/{issueIdOrKey}/watchers"},
+	{Name: "jira_delete_issue_worklog", Legacy: "delete_api_2_issue_issueIdOrKey_worklog_id", OperationID: "deleteWorklog", Method: "DELETE", Path: "/api/2/issue/{issueIdOrKey}/worklog/{id}"},
+	{Name: "jira_delete_issue_type", Legacy: "delete_api_2_issuetype_id", OperationID: "", Method: "DELETE", Path: "/api/2/issuetype/{id}"},
+	{Name: "jira_delete_issue_type_property", Legacy: "delete_api_2_issuetype_issueTypeId_properties_propertyKey", OperationID: "", Method: "DELETE", Path: "/api/2/issuetype/{issueTypeId}/properties/{propertyKey}"},
+	{Name: "jira_delete_my_preference", Legacy: "delete_api_2_mypreferences", OperationID: "removePreference", Method: "DELETE", Path: "/api/2/mypreferences"},
+	{Name: "jira_delete_permission_scheme", Legacy: "delete_api_2_permissionscheme_schemeId", OperationID: "deletePermissionScheme", Method: "DELETE", Path: "/api/2/permissionscheme/{schemeId}"},
+	{Name: "jira_delete_permission_scheme_grant", Legacy: "delete_api_2_permissionscheme_schemeId_permission_permissionId", OperationID: "deletePermissionSchemeEntity", Method: "DELETE", Path: "/api/2/permissionscheme/{schemeId}/permission/{permissionId}"},
+	{Name: "jira_delete_project_category", Legacy: "delete_api_2_projectCategory_id", OperationID: "removeProjectCategory", Method: "DELETE", Path: "/api/2/projectCategory/{id}"},
+	{Name: "jira_delete_project", Legacy: "delete_api_2_project_projectIdOrKey", OperationID: "deleteProject", Method: "DELETE", Path: "/api/2/project/{projectIdOrKey}"},
+	{Name: "jira_delete_project_avatar", Legacy: "delete_api_2_project_projectIdOrKey_avatar_id", OperationID: "", Method: "DELETE", Path: "/api/2/project/{projectIdOrKey}/avatar/{id}"},
+	{Name: "jira_delete_project_property", Legacy: "delete_api_2_project_projectIdOrKey_properties_propertyKey", OperationID: "", Method: "DELETE", Path: "/api/2/project/{projectIdOrKey}/properties/{propertyKey}"},
+	{Name: "jira_delete_project_role_actor", Legacy: "delete_api_2_project_projectIdOrKey_role_id", OperationID: "deleteActor", Method: "DELETE", Path: "/api/2/project/{projectIdOrKey}/role/{id}"},
+	{Name: "jira_delete_role", Legacy: "delete_api_2_role_id", OperationID: "deleteProjectRole", Method: "DELETE", Path: "/api/2/role/{id}"},
+	{Name: "jira_delete_project_role_default_actors", Legacy: "delete_api_2_role_id_actors", OperationID: "deleteProjectRoleActorsFromRole", Method: "DELETE", Path: "/api/2/role/{id}/actors"},
+	{Name: "jira_delete_screen_tab", Legacy: "delete_api_2_screens_screenId_tabs_tabId", OperationID: "deleteTab", Method: "DELETE", Path: "/api/2/screens/{screenId}/tabs/{tabId}"},
+	{Name: "jira_remove_screen_tab_field", Legacy: "delete_api_2_screens_screenId_tabs_tabId_fields_id", OperationID: "removeField", Method: "DELETE", Path: "/api/2/screens/{screenId}/tabs/{tabId}/fields/{id}"},
+	{Name: "jira_delete_universal_avatar", Legacy: "delete_api_2_universal_avatar_type_type_owner_owningObjectId_avatar_id", OperationID: "", Method: "DELETE", Path: "/api/2/universal_avatar/type/{type}/owner/{owningObjectId}/avatar/{id}"},
+	{Name: "jira_delete_user", Legacy: "delete_api_2_user", OperationID: "removeUser", Method: "DELETE", Path: "/api/2/user"},
+	{Name: "jira_remove_user_from_application", Legacy: "delete_api_2_user_application", OperationID: "removeUserFromApplication", Method: "DELETE", Path: "/api/2/user/application"},
+	{Name: "jira_delete_user_avatar", Legacy: "delete_api_2_user_avatar_id", OperationID: "", Method: "DELETE", Path: "/api/2/user/avatar/{id}"},
+	{Name: "jira_reset_user_columns", Legacy: "delete_api_2_user_columns", OperationID: "", Method: "DELETE", Path: "/api/2/user/columns"},
+	{Name: "jira_delete_user_property", Legacy: "delete_api_2_user_properties_propertyKey", OperationID: "", Method: "DELETE", Path: "/api/2/user/properties/{propertyKey}"},
+	{Name: "jira_delete_version", Legacy: "delete_api_2_version_id", OperationID: "", Method: "DELETE", Path: "/api/2/version/{id}"},
+	{Name: "jira_delete_version_remote_links", Legacy: "delete_api_2_version_versionId_remotelink", OperationID: "deleteRemoteVersionLinksByVersionId", Method: "DELETE", Path: "/api/2/version/{versionId}/remotelink"},
+	{Name: "jira_delete_version_remote_link", Legacy: "delete_api_2_version_versionId_remotelink_globalId", OperationID: "deleteRemoteVersionLink", Method: "DELETE", Path: "/api/2/version/{versionId}/remotelink/{globalId}"},
+	{Name: "jira_delete_workflow_transition_property", Legacy: "delete_api_2_workflow_api_2_transitions_id_properties", OperationID: "", Method: "DELETE", Path: "/api/2/workflow/api/2/transitions/{id}/properties"},
+	{Name: "jira_delete_workflow_scheme", Legacy: "delete_api_2_workflowscheme_id", OperationID: "deleteScheme", Method: "DELETE", Path: "/api/2/workflowscheme/{id}"},
+	{Name: "jira_delete_workflow_scheme_default", Legacy: "delete_api_2_workflowscheme_id_default", OperationID: "deleteDefault", Method: "DELETE", Path: "/api/2/workflowscheme/{id}/default"},
+	{Name: "jira_delete_workflow_scheme_draft", Legacy: "delete_api_2_workflowscheme_id_draft", OperationID: "deleteDraftById", Method: "DELETE", Path: "/api/2/workflowscheme/{id}/draft"},
+	{Name: "jira_delete_workflow_scheme_draft_default", Legacy: "delete_api_2_workflowscheme_id_draft_default", OperationID: "deleteDraftDefault", Method: "DELETE", Path: "/api/2/workflowscheme/{id}/draft/default"},
+	{Name: "jira_delete_workflow_scheme_draft_issue_type_mapping", Legacy: "delete_api_2_workflowscheme_id_draft_issuetype_issueType", OperationID: "deleteDraftIssueType", Method: "DELETE", Path: "/api/2/workflowscheme/{id}/draft/issuetype/{issueType}"},
+	{Name: "jira_delete_workflow_scheme_draft_workflow_mapping", Legacy: "delete_api_2_workflowscheme_id_draft_workflow", OperationID: "deleteDraftWorkflowMapping", Method: "DELETE", Path: "/api/2/workflowscheme/{id}/draft/workflow"},
+	{Name: "jira_delete_workflow_scheme_issue_type_mapping", Legacy: "delete_api_2_workflowscheme_id_issuetype_issueType", OperationID: "", Method: "DELETE", Path: "/api/2/workflowscheme/{id}/issuetype/{issueType}"},
+	{Name: "jira_delete_workflow_scheme_workflow_mapping", Legacy: "delete_api_2_workflowscheme_id_workflow", OperationID: "deleteWorkflowMapping", Method: "DELETE", Path: "/api/2/workflowscheme/{id}/workflow"},
+	{Name: "jira_logout", Legacy: "delete_auth_1_session", OperationID: "logout", Method: "DELETE", Path: "/auth/1/session"},
+	{Name: "jira_release_websudo", Legacy: "delete_auth_1_websudo", OperationID: "release", Method: "DELETE", Path: "/auth/1/websudo"},
+	{Name: "jira_get_application_property", Legacy: "get_api_2_application-properties", OperationID: "", Method: "GET", Path: "/api/2/application-properties"},
+	{Name: "jira_get_advanced_settings", Legacy: "get_api_2_application-properties_advanced-settings", OperationID: "getAdvancedSettings", Method: "GET", Path: "/api/2/application-properties/advanced-settings"},
+	{Name: "jira_list_application_roles", Legacy: "get_api_2_applicationrole", OperationID: "getAll", Method: "GET", Path: "/api/2/applicationrole"},
+	{Name: "jira_get_application_role", Legacy: "get_api_2_applicationrole_key", OperationID: "get", Method: "GET", Path: "/api/2/applicationrole/{key}"},
+	{Name: "jira_get_attachment", Legacy: "get_api_2_attachment_id", OperationID: "getAttachment", Method: "GET", Path: "/api/2/attachment/{id}"},
+	{Name: "jira_expand_attachment_for_humans", Legacy: "get_api_2_attachment_id_expand_human", OperationID: "expandForHumans", Method: "GET", Path: "/api/2/attachment/{id}/expand/human"},
+	{Name: "jira_expand_attachment_raw", Legacy: "get_api_2_attachment_id_expand_raw", OperationID: "expandForMachines", Method: "GET", Path: "/api/2/attachment/{id}/expand/raw"},
+	{Name: "jira_get_attachment_meta", Legacy: "get_api_2_attachment_meta", OperationID: "getAttachmentMeta", Method: "GET", Path: "/api/2/attachment/meta"},
+	{Name: "jira_list_audit_records", Legacy: "get_api_2_auditing_record", OperationID: "getRecords", Method: "GET", Path: "/api/2/auditing/record"},
+	{Name: "jira_list_system_avatars", Legacy: "get_api_2_avatar_type_system", OperationID: "getAllSystemAvatars", Method: "GET", Path: "/api/2/avatar/{type}/system"},
+	{Name: "jira_get_zdu_state", Legacy: "get_api_2_cluster_zdu_state", OperationID: "getState", Method: "GET", Path: "/api/2/cluster/zdu/state"},
+	{Name: "jira_list_comment_property_keys", Legacy: "get_api_2_comment_commentId_properties", OperationID: "", Method: "GET", Path: "/api/2/comment/{commentId}/properties"},
+	{Name: "jira_get_comment_property", Legacy: "get_api_2_comment_commentId_properties_propertyKey", OperationID: "", Method: "GET", Path: "/api/2/comment/{commentId}/properties/{propertyKey}"},
+	{Name: "jira_get_component", Legacy: "get_api_2_component_id", OperationID: "getComponent", Method: "GET", Path: "/api/2/component/{id}"},
+	{Name: "jira_get_component_related_issue_counts", Legacy: "get_api_2_component_id_relatedIssueCounts", OperationID: "getComponentRelatedIssues", Method: "GET", Path: "/api/2/component/{id}/relatedIssueCounts"},
+	{Name: "jira_get_configuration", Legacy: "get_api_2_configuration", OperationID: "getConfiguration", Method: "GET", Path: "/api/2/configuration"},
+	{Name: "jira_get_custom_field_option", Legacy: "get_api_2_customFieldOption_id", OperationID: "getCustomFieldOption", Method: "GET", Path: "/api/2/customFieldOption/{id}"},
+	{Name: "jira_list_dashboards", Legacy: "get_api_2_dashboard", OperationID: "list", Method: "GET", Path: "/api/2/dashboard"},
+	{Name: "jira_list_dashboard_item_property_keys", Legacy: "get_api_2_dashboard_dashboardId_items_itemId_properties", OperationID: "", Method: "GET", Path: "/api/2/dashboard/{dashboardId}/items/{itemId}/properties"},
+	{Name: "jira_get_dashboard_item_property", Legacy: "get_api_2_dashboard_dashboardId_items_itemId_properties_propertyKey", OperationID: "", Method: "GET", Path: "/api/2/dashboard/{dashboardId}/items/{itemId}/properties/{propertyKey}"},
+	{Name: "jira_get_dashboard", Legacy: "get_api_2_dashboard_id", OperationID: "getDashboard", Method: "GET", Path: "/api/2/dashboard/{id}"},
+	{Name: "jira_list_fields", Legacy: "get_api_2_field", OperationID: "getFields", Method: "GET", Path: "/api/2/field"},
+	{Name: "jira_get_filter_default_share_scope", Legacy: "get_api_2_filter_defaultShareScope", OperationID: "getDefaultShareScope", Method: "GET", Path: "/api/2/filter/defaultShareScope"},
+	{Name: "jira_list_favourite_filters", Legacy: "get_api_2_filter_favourite", OperationID: "getFavouriteFilters", Method: "GET", Path: "/api/2/filter/favourite"},
+	{Name: "jira_get_filter", Legacy: "get_api_2_filter_id", OperationID: "getFilter", Method: "GET", Path: "/api/2/filter/{id}"},
+	{Name: "jira_get_filter_columns", Legacy: "get_api_2_filter_id_columns", OperationID: "", Method: "GET", Path: "/api/2/filter/{id}/columns"},
+	{Name: "jira_list_filter_share_permissions", Legacy: "get_api_2_filter_id_permission", OperationID: "getSharePermissions", Method: "GET", Path: "/api/2/filter/{id}/permission"},
+	{Name: "jira_get_filter_share_permission", Legacy: "get_api_2_filter_id_permission_permissionId", OperationID: "getSharePermission", Method: "GET", Path: "/api/2/filter/{id}/permission/{permissionId}"},
+	{Name: "jira_get_group", Legacy: "get_api_2_group", OperationID: "getGroup", Method: "GET", Path: "/api/2/group"},
+	{Name: "jira_list_group_members", Legacy: "get_api_2_group_member", OperationID: "getUsersFromGroup", Method: "GET", Path: "/api/2/group/member"},
+	{Name: "jira_find_groups", Legacy: "get_api_2_groups_picker", OperationID: "findGroups", Method: "GET", Path: "/api/2/groups/picker"},
+	{Name: "jira_find_users_and_groups", Legacy: "get_api_2_groupuserpicker", OperationID: "findUsersAndGroups", Method: "GET", Path: "/api/2/groupuserpicker"},
+	{Name: "jira_get_index_summary", Legacy: "get_api_2_index_summary", OperationID: "getIndexSummary", Method: "GET", Path: "/api/2/index/summary"},
+	{Name: "jira_list_issue_link_types", Legacy: "get_api_2_issueLinkType", OperationID: "getIssueLinkTypes", Method: "GET", Path: "/api/2/issueLinkType"},
+	{Name: "jira_get_issue_link_type", Legacy: "get_api_2_issueLinkType_issueLinkTypeId", OperationID: "getIssueLinkType", Method: "GET", Path: "/api/2/issueLinkType/{issueLinkTypeId}"},
+	{Name: "jira_get_issue_link", Legacy: "get_api_2_issueLink_linkId", OperationID: "getIssueLink", Method: "GET", Path: "/api/2/issueLink/{linkId}"},
+	{Name: "jira_get_create_issue_meta", Legacy: "get_api_2_issue_createmeta", OperationID: "getCreateIssueMeta", Method: "GET", Path: "/api/2/issue/createmeta"},
+	{Name: "jira_get_issue", Legacy: "get_api_2_issue_issueIdOrKey", OperationID: "getIssue", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}"},
+	{Name: "jira_list_issue_comments", Legacy: "get_api_2_issue_issueIdOrKey_comment", OperationID: "getComments", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/comment"},
+	{Name: "jira_get_issue_comment", Legacy: "get_api_2_issue_issueIdOrKey_comment_id", OperationID: "getComment", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/comment/{id}"},
+	{Name: "jira_get_edit_issue_meta", Legacy: "get_api_2_issue_issueIdOrKey_editmeta", OperationID: "getEditIssueMeta", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/editmeta"},
+	{Name: "jira_list_issue_property_keys", Legacy: "get_api_2_issue_issueIdOrKey_properties", OperationID: "", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/properties"},
+	{Name: "jira_get_issue_property", Legacy: "get_api_2_issue_issueIdOrKey_properties_propertyKey", OperationID: "", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/properties/{propertyKey}"},
+	{Name: "jira_list_remote_issue_links", Legacy: "get_api_2_issue_issueIdOrKey_remotelink", OperationID: "getRemoteIssueLinks", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/remotelink"},
+	{Name: "jira_get_remote_issue_link", Legacy: "get_api_2_issue_issueIdOrKey_remotelink_linkId", OperationID: "getRemoteIssueLinkById", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/remotelink/{linkId}"},
+	{Name: "jira_list_subtasks", Legacy: "get_api_2_issue_issueIdOrKey_subtask", OperationID: "getSubTasks", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/subtask"},
+	{Name: "jira_can_move_subtask", Legacy: "get_api_2_issue_issueIdOrKey_subtask_move", OperationID: "canMoveSubTask", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/subtask/move"},
+	{Name: "jira_list_issue_transitions", Legacy: "get_api_2_issue_issueIdOrKey_transitions", OperationID: "getTransitions", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/transitions"},
+	{Name: "jira_get_issue_votes", Legacy: "get_api_2_issue_issueIdOrKey_votes", OperationID: "getVotes", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/votes"},
+	{Name: "jira_list_issue_watchers", Legacy: "get_api_2_issue_issueIdOrKey_watchers", OperationID: "getIssueWatchers", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/watchers"},
+	{Name: "jira_list_issue_worklogs", Legacy: "get_api_2_issue_issueIdOrKey_worklog", OperationID: "getIssueWorklog", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/worklog"},
+	{Name: "jira_get_issue_worklog", Legacy: "get_api_2_issue_issueIdOrKey_worklog_id", OperationID: "getWorklog", Method: "GET", Path: "/api/2/issue/{issueIdOrKey}/worklog/{id}"},
+	{Name: "jira_issue_picker", Legacy: "get_api_2_issue_picker", OperationID: "getIssuePickerResource", Method: "GET", Path: "/api/2/issue/picker"},
+	{Name: "jira_list_issue_security_schemes", Legacy: "get_api_2_issuesecurityschemes", OperationID: "getIssueSecuritySchemes", Method: "GET", Path: "/api/2/issuesecurityschemes"},
+	{Name: "jira_get_issue_security_scheme", Legacy: "get_api_2_issuesecurityschemes_id", OperationID: "", Method: "GET", Path: "/api/2/issuesecurityschemes/{id}"},
+	{Name: "jira_list_issue_types", Legacy: "get_api_2_issuetype", OperationID: "getIssueAllTypes", Method: "GET", Path: "/api/2/issuetype"},
+	{Name: "jira_get_issue_type", Legacy: "get_api_2_issuetype_id", OperationID: "", Method: "GET", Path: "/api/2/issuetype/{id}"},
+	{Name: "jira_list_issue_type_alternatives", Legacy: "get_api_2_issuetype_id_alternatives", OperationID: "getAlternativeIssueTypes", Method: "GET", Path: "/api/2/issuetype/{id}/alternatives"},
+	{Name: "jira_list_issue_type_property_keys", Legacy: "get_api_2_issuetype_issueTypeId_properties", OperationID: "getPropertyKeys", Method: "GET", Path: "/api/2/issuetype/{issueTypeId}/properties"},
+	{Name: "jira_get_issue_type_property", Legacy: "get_api_2_issuetype_issueTypeId_properties_propertyKey", OperationID: "", Method: "GET", Path: "/api/2/issuetype/{issueTypeId}/properties/{propertyKey}"},
+	{Name: "jira_get_jql_autocomplete_data", Legacy: "get_api_2_jql_autocompletedata", OperationID: "getAutoComplete", Method: "GET", Path: "/api/2/jql/autocompletedata"},
+	{Name: "jira_get_jql_autocomplete_suggestions", Legacy: "get_api_2_jql_autocompletedata_suggestions", OperationID: "getFieldAutoCompleteForQueryString", Method: "GET", Path: "/api/2/jql/autocompletedata/suggestions"},
+	{Name: "jira_are_jmx_metrics_exposed", Legacy: "get_api_2_monitoring_jmx_areMetricsExposed", OperationID: "areMetricsExposed", Method: "GET", Path: "/api/2/monitoring/jmx/areMetricsExposed"},
+	{Name: "jira_list_jmx_metrics", Legacy: "get_api_2_monitoring_jmx_getAvailableMetrics", OperationID: "getAvailableMetrics", Method: "GET", Path: "/api/2/monitoring/jmx/getAvailableMetrics"},
+	{Name: "jira_start_exposing_jmx_metrics", Legacy: "get_api_2_monitoring_jmx_startExposing", OperationID: "start", Method: "GET", Path: "/api/2/monitoring/jmx/startExposing"},
+	{Name: "jira_stop_exposing_jmx_metrics", Legacy: "get_api_2_monitoring_jmx_stopExposing", OperationID: "stop", Method: "GET", Path: "/api/2/monitoring/jmx/stopExposing"},
+	{Name: "jira_get_my_permissions", Legacy: "get_api_2_mypermissions", OperationID: "getPermissions", Method: "GET", Path: "/api/2/mypermissions"},
+	{Name: "jira_get_my_preference", Legacy: "get_api_2_mypreferences", OperationID: "getPreference", Method: "GET", Path: "/api/2/mypreferences"},
+	{Name: "jira_get_myself", Legacy: "get_api_2_myself", OperationID: "", Method: "GET", Path: "/api/2/myself"},
+	{Name: "jira_list_notification_schemes", Legacy: "get_api_2_notificationscheme", OperationID: "getNotificationSchemes", Method: "GET", Path: "/api/2/notificationscheme"},
+	{Name: "jira_get_notification_scheme", Legacy: "get_api_2_notificationscheme_id", OperationID: "", Method: "GET", Path: "/api/2/notificationscheme/{id}"},
+	{Name: "jira_get_password_policy", Legacy: "get_api_2_password_policy", OperationID: "getPasswordPolicy", Method: "GET", Path: "/api/2/password/policy"},
+	{Name: "jira_list_permissions", Legacy: "get_api_2_permissions", OperationID: "getAllPermissions", Method: "GET", Path: "/api/2/permissions"},
+	{Name: "jira_list_permission_schemes", Legacy: "get_api_2_permissionscheme", OperationID: "getPermissionSchemes", Method: "GET", Path: "/api/2/permissionscheme"},
+	{Name: "jira_get_permission_scheme_attribute", Legacy: "get_api_2_permissionscheme_permissionSchemeId_attribute_attributeKey", OperationID: "getSchemeAttribute", Method: "GET", Path: "/api/2/permissionscheme/{permissionSchemeId}/attribute/{attributeKey}"},
+	{Name: "jira_get_permission_scheme", Legacy: "get_api_2_permissionscheme_schemeId", OperationID: "getPermissionScheme", Method: "GET", Path: "/api/2/permissionscheme/{schemeId}"},
+	{Name: "jira_list_permission_scheme_grants", Legacy: "get_api_2_permissionscheme_schemeId_permission", OperationID: "getPermissionSchemeGrants", Method: "GET", Path: "/api/2/permissionscheme/{schemeId}/permission"},
+	{Name: "jira_get_permission_scheme_grant", Legacy: "get_api_2_permissionscheme_schemeId_permission_permissionId", OperationID: "getPermissionSchemeGrant", Method: "GET", Path: "/api/2/permissionscheme/{schemeId}/permission/{permissionId}"},
+	{Name: "jira_list_priorities", Legacy: "get_api_2_priority", OperationID: "getPriorities", Method: "GET", Path: "/api/2/priority"},
+	{Name: "jira_get_priority", Legacy: "get_api_2_priority_id", OperationID: "getPriority", Method: "GET", Path: "/api/2/priority/{id}"},
+	{Name: "jira_list_projects", Legacy: "get_api_2_project", OperationID: "getAllProjects", Method: "GET", Path: "/api/2/project"},
+	{Name: "jira_list_project_categories", Legacy: "get_api_2_projectCategory", OperationID: "getAllProjectCategories", Method: "GET", Path: "/api/2/projectCategory"},
+	{Name: "jira_get_project_category", Legacy: "get_api_2_projectCategory_id", OperationID: "getProjectCategoryById", Method: "GET", Path: "/api/2/projectCategory/{id}"},
+	{Name: "jira_get_project", Legacy: "get_api_2_project_projectIdOrKey", OperationID: "", Method: "GET", Path: "/api/2/project/{projectIdOrKey}"},
+	{Name: "jira_list_project_avatars", Legacy: "get_api_2_project_projectIdOrKey_avatars", OperationID: "", Method: "GET", Path: "/api/2/project/{projectIdOrKey}/avatars"},
+	{Name: "jira_list_project_components", Legacy: "get_api_2_project_projectIdOrKey_components", OperationID: "getProjectComponents", Method: "GET", Path: "/api/2/project/{projectIdOrKey}/components"},
+	{Name: "jira_list_project_property_keys", Legacy: "get_api_2_project_projectIdOrKey_properties", OperationID: "", Method: "GET", Path: "/api/2/project/{projectIdOrKey}/properties"},
+	{Name: "jira_get_project_property", Legacy: "get_api_2_project_projectIdOrKey_properties_propertyKey", OperationID: "", Method: "GET", Path: "/api/2/project/{projectIdOrKey}/properties/{propertyKey}"},
+	{Name: "jira_list_project_roles", Legacy: "get_api_2_project_projectIdOrKey_role", OperationID: "", Method: "GET", Path: "/api/2/project/{projectIdOrKey}/role"},
+	{Name: "jira_get_project_role", Legacy: "get_api_2_project_projectIdOrKey_role_id", OperationID: "getProjectRole", Method: "GET", Path: "/api/2/project/{projectIdOrKey}/role/{id}"},
+	{Name: "jira_list_project_statuses", Legacy: "get_api_2_project_projectIdOrKey_statuses", OperationID: "getAllStatuses", Method: "GET", Path: "/api/2/project/{projectIdOrKey}/statuses"},
+	{Name: "jira_list_project_versions_paginated", Legacy: "get_api_2_project_projectIdOrKey_version", OperationID: "getProjectVersionsPaginated", Method: "GET", Path: "/api/2/project/{projectIdOrKey}/version"},
+	{Name: "jira_list_project_versions", Legacy: "get_api_2_project_projectIdOrKey_versions", OperationID: "getProjectVersions", Method: "GET", Path: "/api/2/project/{projectIdOrKey}/versions"},
+	{Name: "jira_get_project_issue_security_scheme", Legacy: "get_api_2_project_projectKeyOrId_issuesecuritylevelscheme", OperationID: "", Method: "GET", Path: "/api/2/project/{projectKeyOrId}/issuesecuritylevelscheme"},
+	{Name: "jira_get_project_notification_scheme", Legacy: "get_api_2_project_projectKeyOrId_notificationscheme", OperationID: "", Method: "GET", Path: "/api/2/project/{projectKeyOrId}/notificationscheme"},
+	{Name: "jira_get_project_permission_scheme", Legacy: "get_api_2_project_projectKeyOrId_permissionscheme", OperationID: "getAssignedPermissionScheme", Method: "GET", Path: "/api/2/project/{projectKeyOrId}/permissionscheme"},
+	{Name: "jira_list_project_security_levels", Legacy: "get_api_2_project_projectKeyOrId_securitylevel", OperationID: "getSecurityLevelsForProject", Method: "GET", Path: "/api/2/project/{projectKeyOrId}/securitylevel"},
+	{Name: "jira_list_project_types", Legacy: "get_api_2_project_type", OperationID: "getAllProjectTypes", Method: "GET", Path: "/api/2/project/type"},
+	{Name: "jira_get_project_type", Legacy: "get_api_2_project_type_projectTypeKey", OperationID: "getProjectTypeByKey", Method: "GET", Path: "/api/2/project/type/{projectTypeKey}"},
+	{Name: "jira_get_accessible_project_type", Legacy: "get_api_2_project_type_projectTypeKey_accessible", OperationID: "getAccessibleProjectTypeByKey", Method: "GET", Path: "/api/2/project/type/{projectTypeKey}/accessible"},
+	{Name: "jira_validate_project_key", Legacy: "get_api_2_projectvalidate_key", OperationID: "", Method: "GET", Path: "/api/2/projectvalidate/key"},
+	{Name: "jira_get_reindex_info", Legacy: "get_api_2_reindex", OperationID: "getReindexInfo", Method: "GET", Path: "/api/2/reindex"},
+	{Name: "jira_get_reindex_progress", Legacy: "get_api_2_reindex_progress", OperationID: "getReindexProgress", Method: "GET", Path: "/api/2/reindex/progress"},
+	{Name: "jira_get_reindex_requests_progress", Legacy: "get_api_2_reindex_request_bulk", OperationID: "getProgressBulk", Method: "GET", Path: "/api/2/reindex/request/bulk"},
+	{Name: "jira_get_reindex_request_progress", Legacy: "get_api_2_reindex_request_requestId", OperationID: "getProgress", Method: "GET", Path: "/api/2/reindex/request/{requestId}"},
+	{Name: "jira_list_resolutions", Legacy: "get_api_2_resolution", OperationID: "getResolutions", Method: "GET", Path: "/api/2/resolution"},
+	{Name: "jira_get_resolution", Legacy: "get_api_2_resolution_id", OperationID: "getResolution", Method: "GET", Path: "/api/2/resolution/{id}"},
+	{Name: "jira_list_roles", Legacy: "get_api_2_role", OperationID: "", Method: "GET", Path: "/api/2/role"},
+	{Name: "jira_get_role", Legacy: "get_api_2_role_id", OperationID: "getProjectRolesById", Method: "GET", Path: "/api/2/role/{id}"},
+	{Name: "jira_list_role_default_actors", Legacy: "get_api_2_role_id_actors", OperationID: "getProjectRoleActorsForRole", Method: "GET", Path: "/api/2/role/{id}/actors"},
+	{Name: "jira_list_screen_available_fields", Legacy: "get_api_2_screens_screenId_availableFields", OperationID: "getFieldsToAdd", Method: "GET", Path: "/api/2/screens/{screenId}/availableFields"},
+	{Name: "jira_list_screen_tabs", Legacy: "get_api_2_screens_screenId_tabs", OperationID: "getAllTabs", Method: "GET", Path: "/api/2/screens/{screenId}/tabs"},
+	{Name: "jira_list_screen_tab_fields", Legacy: "get_api_2_screens_screenId_tabs_tabId_fields", OperationID: "getAllFields", Method: "GET", Path: "/api/2/screens/{screenId}/tabs/{tabId}/fields"},
+	{Name: "jira_search_issues", Legacy: "get_api_2_search", OperationID: "search", Method: "GET", Path: "/api/2/search"},
+	{Name: "jira_get_issue_security_level", Legacy: "get_api_2_securitylevel_id", OperationID: "getIssuesecuritylevel", Method: "GET", Path: "/api/2/securitylevel/{id}"},
+	{Name: "jira_get_server_info", Legacy: "get_api_2_serverInfo", OperationID: "getServerInfo", Method: "GET", Path: "/api/2/serverInfo"},
+	{Name: "jira_get_issue_navigator_default_columns", Legacy: "get_api_2_settings_columns", OperationID: "getIssueNavigatorDefaultColumns", Method: "GET", Path: "/api/2/settings/columns"},
+	{Name: "jira_list_statuses", Legacy: "get_api_2_status", OperationID: "getStatuses", Method: "GET", Path: "/api/2/status"},
+	{Name: "jira_get_status", Legacy: "get_api_2_status_idOrName", OperationID: "getStatus", Method: "GET", Path: "/api/2/status/{idOrName}"},
+	{Name: "jira_list_status_categories", Legacy: "get_api_2_statuscategory", OperationID: "getStatusCategories", Method: "GET", Path: "/api/2/statuscategory"},
+	{Name: "jira_get_status_category", Legacy: "get_api_2_statuscategory_idOrKey", OperationID: "getStatusCategory", Method: "GET", Path: "/api/2/statuscategory/{idOrKey}"},
+	{Name: "jira_list_universal_avatars", Legacy: "get_api_2_universal_avatar_type_type_owner_owningObjectId", OperationID: "getAvatars", Method: "GET", Path: "/api/2/universal_avatar/type/{type}/owner/{owningObjectId}"},
+	{Name: "jira_get_upgrade_result", Legacy: "get_api_2_upgrade", OperationID: "getUpgradeResult", Method: "GET", Path: "/api/2/upgrade"},
+	{Name: "jira_get_user", Legacy: "get_api_2_user", OperationID: "", Method: "GET", Path: "/api/2/user"},
+	{Name: "jira_find_bulk_assignable_users", Legacy: "get_api_2_user_assignable_multiProjectSearch", OperationID: "findBulkAssignableUsers", Method: "GET", Path: "/api/2/user/assignable/multiProjectSearch"},
+	{Name: "jira_find_assignable_users", Legacy: "get_api_2_user_assignable_search", OperationID: "findAssignableUsers", Method: "GET", Path: "/api/2/user/assignable/search"},
+	{Name: "jira_list_user_avatars", Legacy: "get_api_2_user_avatars", OperationID: "", Method: "GET", Path: "/api/2/user/avatars"},
+	{Name: "jira_get_user_columns", Legacy: "get_api_2_user_columns", OperationID: "", Method: "GET", Path: "/api/2/user/columns"},
+	{Name: "jira_find_users_with_all_permissions", Legacy: "get_api_2_user_permission_search", OperationID: "findUsersWithAllPermissions", Method: "GET", Path: "/api/2/user/permission/search"},
+	{Name: "jira_find_users_for_picker", Legacy: "get_api_2_user_picker", OperationID: "findUsersForPicker", Method: "GET", Path: "/api/2/user/picker"},
+	{Name: "jira_list_user_property_keys", Legacy: "get_api_2_user_properties", OperationID: "", Method: "GET", Path: "/api/2/user/properties/"},
+	{Name: "jira_get_user_property", Legacy: "get_api_2_user_properties_propertyKey", OperationID: "", Method: "GET", Path: "/api/2/user/properties/{propertyKey}"},
+	{Name: "jira_find_users", Legacy: "get_api_2_user_search", OperationID: "findUsers", Method: "GET", Path: "/api/2/user/search"},
+	{Name: "jira_find_users_with_browse_permission", Legacy: "get_api_2_user_viewissue_search", OperationID: "findUsersWithBrowsePermission", Method: "GET", Path: "/api/2/user/viewissue/search"},
+	{Name: "jira_get_version", Legacy: "get_api_2_version_id", OperationID: "getVersion", Method: "GET", Path: "/api/2/version/{id}"},
+	{Name: "jira_get_version_related_issue_counts", Legacy: "get_api_2_version_id_relatedIssueCounts", OperationID: "getVersionRelatedIssues", Method: "GET", Path: "/api/2/version/{id}/relatedIssueCounts"},
+	{Name: "jira_get_version_unresolved_issue_count", Legacy: "get_api_2_version_id_unresolvedIssueCount", OperationID: "getVersionUnresolvedIssues", Method: "GET", Path: "/api/2/version/{id}/unresolvedIssueCount"},
+	{Name: "jira_list_version_remote_links_by_global_id", Legacy: "get_api_2_version_remotelink", OperationID: "getRemoteVersionLinks", Method: "GET", Path: "/api/2/version/remotelink"},
+	{Name: "jira_list_version_remote_links", Legacy: "get_api_2_version_versionId_remotelink", OperationID: "getRemoteVersionLinksByVersionId", Method: "GET", Path: "/api/2/version/{versionId}/remotelink"},
+	{Name: "jira_get_version_remote_link", Legacy: "get_api_2_version_versionId_remotelink_globalId", OperationID: "getRemoteVersionLink", Method: "GET", Path: "/api/2/version/{versionId}/remotelink/{globalId}"},
+	{Name: "jira_list_workflows", Legacy: "get_api_2_workflow", OperationID: "getAllWorkflows", Method: "GET", Path: "/api/2/workflow"},
+	{Name: "jira_get_workflow_transition_properties", Legacy: "get_api_2_workflow_api_2_transitions_id_properties", OperationID: "getProperties", Method: "GET", Path: "/api/2/workflow/api/2/transitions/{id}/properties"},
+	{Name: "jira_get_workflow_scheme", Legacy: "get_api_2_workflowscheme_id", OperationID: "getById", Method: "GET", Path: "/api/2/workflowscheme/{id}"},
+	{Name: "jira_get_workflow_scheme_default", Legacy: "get_api_2_workflowscheme_id_default", OperationID: "getDefault", Method: "GET", Path: "/api/2/workflowscheme/{id}/default"},
+	{Name: "jira_get_workflow_scheme_draft", Legacy: "get_api_2_workflowscheme_id_draft", OperationID: "getDraftById", Method: "GET", Path: "/api/2/workflowscheme/{id}/draft"},
+	{Name: "jira_get_workflow_scheme_draft_default", Legacy: "get_api_2_workflowscheme_id_draft_default", OperationID: "getDraftDefault", Method: "GET", Path: "/api/2/workflowscheme/{id}/draft/default"},
+	{Name: "jira_get_workflow_scheme_draft_issue_type_mapping", Legacy: "get_api_2_workflowscheme_id_draft_issuetype_issueType", OperationID: "getDraftIssueType", Method: "GET", Path: "/api/2/workflowscheme/{id}/draft/issuetype/{issueType}"},
+	{Name: "jira_get_workflow_scheme_draft_workflow_mapping", Legacy: "get_api_2_workflowscheme_id_draft_workflow", OperationID: "getDraftWorkflow", Method: "GET", Path: "/api/2/workflowscheme/{id}/draft/workflow"},
+	{Name: "jira_get_workflow_scheme_issue_type_mapping", Legacy: "get_api_2_workflowscheme_id_issuetype_issueType", OperationID: "", Method: "GET", Path: "/api/2/workflowscheme/{id}/issuetype/{issueType}"},
+	{Name: "jira_get_workflow_scheme_workflow_mapping", Legacy: "get_api_2_workflowscheme_id_workflow", OperationID: "getWorkflow", Method: "GET", Path: "/api/2/workflowscheme/{id}/workflow"},
+	{Name: "jira_list_deleted_worklog_ids", Legacy: "get_api_2_worklog_deleted", OperationID: "getIdsOfWorklogsDeletedSince", Method: "GET", Path: "/api/2/worklog/deleted"},
+	{Name: "jira_list_updated_worklog_ids", Legacy: "get_api_2_worklog_updated", OperationID: "getIdsOfWorklogsModifiedSince", Method: "GET", Path: "/api/2/worklog/updated"},
+	{Name: "jira_get_current_session", Legacy: "get_auth_1_session", OperationID: "currentUser", Method: "GET", Path: "/auth/1/session"},
+	{Name: "jira_add_audit_record", Legacy: "post_api_2_auditing_record", OperationID: "addRecord", Method: "POST", Path: "/api/2/auditing/record"},
+	{Name: "jira_store_temporary_avatar", Legacy: "post_api_2_avatar_type_temporary", OperationID: "storeTemporaryAvatar", Method: "POST", Path: "/api/2/avatar/{type}/temporary"},
+	{Name: "jira_crop_temporary_avatar", Legacy: "post_api_2_avatar_type_temporaryCrop", OperationID: "", Method: "POST", Path: "/api/2/avatar/{type}/temporaryCrop"},
+	{Name: "jira_approve_zdu_upgrade", Legacy: "post_api_2_cluster_zdu_approve", OperationID: "approveUpgrade", Method: "POST", Path: "/api/2/cluster/zdu/approve"},
+	{Name: "jira_cancel_zdu_upgrade", Legacy: "post_api_2_cluster_zdu_cancel", OperationID: "cancelUpgrade", Method: "POST", Path: "/api/2/cluster/zdu/cancel"},
+	{Name: "jira_retry_zdu_upgrade", Legacy: "post_api_2_cluster_zdu_retryUpgrade", OperationID: "acknowledgeErrors", Method: "POST", Path: "/api/2/cluster/zdu/retryUpgrade"},
+	{Name: "jira_start_zdu_upgrade", Legacy: "post_api_2_cluster_zdu_start", OperationID: "setReadyToUpgrade", Method: "POST", Path: "/api/2/cluster/zdu/start"},
+	{Name: "jira_create_component", Legacy: "post_api_2_component", OperationID: "createComponent", Method: "POST", Path: "/api/2/component"},
+	{Name: "jira_create_custom_field", Legacy: "post_api_2_field", OperationID: "createCustomField", Method: "POST", Path: "/api/2/field"},
+	{Name: "jira_create_filter", Legacy: "post_api_2_filter", OperationID: "createFilter", Method: "POST", Path: "/api/2/filter"},
+	{Name: "jira_add_filter_share_permission", Legacy: "post_api_2_filter_id_permission", OperationID: "addSharePermission", Method: "POST", Path: "/api/2/filter/{id}/permission"},
+	{Name: "jira_create_group", Legacy: "post_api_2_group", OperationID: "createGroup", Method: "POST", Path: "/api/2/group"},
+	{Name: "jira_add_user_to_group", Legacy: "post_api_2_group_user", OperationID: "addUserToGroup", Method: "POST", Path: "/api/2/group/user"},
+	{Name: "jira_create_issue", Legacy: "post_api_2_issue", OperationID: "createIssue", Method: "POST", Path: "/api/2/issue"},
+	{Name: "jira_link_issues", Legacy: "post_api_2_issueLink", OperationID: "linkIssues", Method: "POST", Path: "/api/2/issueLink"},
+	{Name: "jira_create_issue_link_type", Legacy: "post_api_2_issueLinkType", OperationID: "createIssueLinkType", Method: "POST", Path: "/api/2/issueLinkType"},
+	{Name: "jira_create_issues_bulk", Legacy: "post_api_2_issue_bulk", OperationID: "createIssues", Method: "POST", Path: "/api/2/issue/bulk"},
+	{Name: "jira_add_issue_attachment", Legacy: "post_api_2_issue_issueIdOrKey_attachments", OperationID: "addAttachment", Method: "POST", Path: "/api/2/issue/{issueIdOrKey}/attachments"},
+	{Name: "jira_add_issue_comment", Legacy: "post_api_2_issue_issueIdOrKey_comment", OperationID: "addComment", Method: "POST", Path: "/api/2/issue/{issueIdOrKey}/comment"},
+	{Name: "jira_notify_issue", Legacy: "post_api_2_issue_issueIdOrKey_notify", OperationID: "notify", Method: "POST", Path: "/api/2/issue/{issueIdOrKey}/notify"},
+	{Name: "jira_create_or_update_remote_issue_link", Legacy: "post_api_2_issue_issueIdOrKey_remotelink", OperationID: "createOrUpdateRemoteIssueLink", Method: "POST", Path: "/api/2/issue/{issueIdOrKey}/remotelink"},
+	{Name: "jira_move_subtask", Legacy: "post_api_2_issue_issueIdOrKey_subtask_move", OperationID: "moveSubTasks", Method: "POST", Path: "/api/2/issue/{issueIdOrKey}/subtask/move"},
+	{Name: "jira_do_issue_transition", Legacy: "post_api_2_issue_issueIdOrKey_transitions", OperationID: "doTransition", Method: "POST", Path: "/api/2/issue/{issueIdOrKey}/transitions"},
+	{Name: "jira_add_issue_vote", Legacy: "post_api_2_issue_issueIdOrKey_votes", OperationID: "addVote", Method: "POST", Path: "/api/2/issue/{issueIdOrKey}/votes"},
+	{Name: "jira_add_issue_watcher", Legacy: "post_api_2_issue_issueIdOrKey_watchers", OperationID: "addWatcher", Method: "POST", Path: "/api/2/issue/{issueIdOrKey}/watchers"},
+	{Name: "jira_add_issue_worklog", Legacy: "post_api_2_issue_issueIdOrKey_worklog", OperationID: "addWorklog", Method: "POST", Path: "/api/2/issue/{issueIdOrKey}/worklog"},
+	{Name: "jira_create_issue_type", Legacy: "post_api_2_issuetype", OperationID: "createIssueType", Method: "POST", Path: "/api/2/issuetype"},
+	{Name: "jira_crop_issue_type_avatar", Legacy: "post_api_2_issuetype_id_avatar", OperationID: "", Method: "POST", Path: "/api/2/issuetype/{id}/avatar"},
+	{Name: "jira_store_issue_type_temporary_avatar", Legacy: "post_api_2_issuetype_id_avatar_temporary", OperationID: "", Method: "POST", Path: "/api/2/issuetype/{id}/avatar/temporary"},
+	{Name: "jira_validate_license", Legacy: "post_api_2_licenseValidator", OperationID: "validate", Method: "POST", Path: "/api/2/licenseValidator"},
+	{Name: "jira_check_password_policy_for_new_user", Legacy: "post_api_2_password_policy_createUser", OperationID: "policyCheckCreateUser", Method: "POST", Path: "/api/2/password/policy/createUser"},
+	{Name: "jira_check_password_policy_for_user_update", Legacy: "post_api_2_password_policy_updateUser", OperationID: "policyCheckUpdateUser", Method: "POST", Path: "/api/2/password/policy/updateUser"},
+	{Name: "jira_create_permission_scheme", Legacy: "post_api_2_permissionscheme", OperationID: "createPermissionScheme", Method: "POST", Path: "/api/2/permissionscheme"},
+	{Name: "jira_create_permission_scheme_grant", Legacy: "post_api_2_permissionscheme_schemeId_permission", OperationID: "createPermissionGrant", Method: "POST", Path: "/api/2/permissionscheme/{schemeId}/permission"},
+	{Name: "jira_create_project", Legacy: "post_api_2_project", OperationID: "createProject", Method: "POST", Path: "/api/2/project"},
+	{Name: "jira_create_project_category", Legacy: "post_api_2_projectCategory", OperationID: "createProjectCategory", Method: "POST", Path: "/api/2/projectCategory"},
+	{Name: "jira_crop_project_avatar", Legacy: "post_api_2_project_projectIdOrKey_avatar", OperationID: "", Method: "POST", Path: "/api/2/project/{projectIdOrKey}/avatar"},
+	{Name: "jira_store_project_temporary_avatar", Legacy: "post_api_2_project_projectIdOrKey_avatar_temporary", OperationID: "", Method: "POST", Path: "/api/2/project/{projectIdOrKey}/avatar/temporary"},
+	{Name: "jira_add_project_role_actors", Legacy: "post_api_2_project_projectIdOrKey_role_id", OperationID: "addActorUsers", Method: "POST", Path: "/api/2/project/{projectIdOrKey}/role/{id}"},
+	{Name: "jira_start_reindex", Legacy: "post_api_2_reindex", OperationID: "reindex", Method: "POST", Path: "/api/2/reindex"},
+	{Name: "jira_reindex_issues", Legacy: "post_api_2_reindex_issue", OperationID: "reindexIssues", Method: "POST", Path: "/api/2/reindex/issue"},
+	{Name: "jira_process_reindex_requests", Legacy: "post_api_2_reindex_request", OperationID: "processRequests", Method: "POST", Path: "/api/2/reindex/request"},
+	{Name: "jira_create_role", Legacy: "post_api_2_role", OperationID: "createProjectRole", Method: "POST", Path: "/api/2/role"},
+	{Name: "jira_partially_update_role", Legacy: "post_api_2_role_id", OperationID: "partialUpdateProjectRole", Method: "POST", Path: "/api/2/role/{id}"},
+	{Name: "jira_add_role_default_actors", Legacy: "post_api_2_role_id_actors", OperationID: "addProjectRoleActorsToRole", Method: "POST", Path: "/api/2/role/{id}/actors"},
+	{Name: "jira_add_field_to_default_screen", Legacy: "post_api_2_screens_addToDefault_fieldId", OperationID: "addFieldToDefaultScreen", Method: "POST", Path: "/api/2/screens/addToDefault/{fieldId}"},
+	{Name: "jira_add_screen_tab", Legacy: "post_api_2_screens_screenId_tabs", OperationID: "addTab", Method: "POST", Path: "/api/2/screens/{screenId}/tabs"},
+	{Name: "jira_add_screen_tab_field", Legacy: "post_api_2_screens_screenId_tabs_tabId_fields", OperationID: "addField", Method: "POST", Path: "/api/2/screens/{screenId}/tabs/{tabId}/fields"},
+	{Name: "jira_move_screen_tab_field", Legacy: "post_api_2_screens_screenId_tabs_tabId_fields_id_move", OperationID: "moveField", Method: "POST", Path: "/api/2/screens/{screenId}/tabs/{tabId}/fields/{id}/move"},
+	{Name: "jira_move_screen_tab", Legacy: "post_api_2_screens_screenId_tabs_tabId_move_pos", OperationID: "moveTab", Method: "POST", Path: "/api/2/screens/{screenId}/tabs/{tabId}/move/{pos}"},
+	{Name: "jira_search_issues_post", Legacy: "post_api_2_search", OperationID: "searchUsingSearchRequest", Method: "POST", Path: "/api/2/search"},
+	{Name: "jira_crop_universal_avatar", Legacy: "post_api_2_universal_avatar_type_type_owner_owningObjectId_avatar", OperationID: "", Method: "POST", Path: "/api/2/universal_avatar/type/{type}/owner/{owningObjectId}/avatar"},
+	{Name: "jira_store_universal_temporary_avatar", Legacy: "post_api_2_universal_avatar_type_type_owner_owningObjectId_temp", OperationID: "", Method: "POST", Path: "/api/2/universal_avatar/type/{type}/owner/{owningObjectId}/temp"},
+	{Name: "jira_run_upgrades_now", Legacy: "post_api_2_upgrade", OperationID: "runUpgradesNow", Method: "POST", Path: "/api/2/upgrade"},
+	{Name: "jira_create_user", Legacy: "post_api_2_user", OperationID: "createUser", Method: "POST", Path: "/api/2/user"},
+	{Name: "jira_add_user_to_application", Legacy: "post_api_2_user_application", OperationID: "addUserToApplication", Method: "POST", Path: "/api/2/user/application"},
+	{Name: "jira_crop_user_avatar", Legacy: "post_api_2_user_avatar", OperationID: "", Method: "POST", Path: "/api/2/user/avatar"},
+	{Name: "jira_store_user_temporary_avatar", Legacy: "post_api_2_user_avatar_temporary", OperationID: "", Method: "POST", Path: "/api/2/user/avatar/temporary"},
+	{Name: "jira_create_version", Legacy: "post_api_2_version", OperationID: "createVersion", Method: "POST", Path: "/api/2/version"},
+	{Name: "jira_move_version", Legacy: "post_api_2_version_id_move", OperationID: "moveVersion", Method: "POST", Path: "/api/2/version/{id}/move"},
+	{Name: "jira_delete_version_and_swap", Legacy: "post_api_2_version_id_removeAndSwap", OperationID: "", Method: "POST", Path: "/api/2/version/{id}/removeAndSwap"},
+	{Name: "jira_create_version_remote_link", Legacy: "post_api_2_version_versionId_remotelink", OperationID: "", Method: "POST", Path: "/api/2/version/{versionId}/remotelink"},
+	{Name: "jira_create_or_update_version_remote_link", Legacy: "post_api_2_version_versionId_remotelink_globalId", OperationID: "", Method: "POST", Path: "/api/2/version/{versionId}/remotelink/{globalId}"},
+	{Name: "jira_create_workflow_transition_property", Legacy: "post_api_2_workflow_api_2_transitions_id_properties", OperationID: "createProperty", Method: "POST", Path: "/api/2/workflow/api/2/transitions/{id}/properties"},
+	{Name: "jira_create_workflow_scheme", Legacy: "post_api_2_workflowscheme", OperationID: "createScheme", Method: "POST", Path: "/api/2/workflowscheme"},
+	{Name: "jira_create_workflow_scheme_draft", Legacy: "post_api_2_workflowscheme_id_createdraft", OperationID: "createDraftForParent", Method: "POST", Path: "/api/2/workflowscheme/{id}/createdraft"},
+	{Name: "jira_list_worklogs_by_ids", Legacy: "post_api_2_worklog_list", OperationID: "getWorklogsForIds", Method: "POST", Path: "/api/2/worklog/list"},
+	{Name: "jira_login", Legacy: "post_auth_1_session", OperationID: "login", Method: "POST", Path: "/auth/1/session"},
+	{Name: "jira_set_application_property", Legacy: "put_api_2_application-properties_id", OperationID: "setPropertyViaRestfulTable", Method: "PUT", Path: "/api/2/application-properties/{id}"},
+	{Name: "jira_update_application_roles", Legacy: "put_api_2_applicationrole", OperationID: "putBulk", Method: "PUT", Path: "/api/2/applicationrole"},
+	{Name: "jira_update_application_role", Legacy: "put_api_2_applicationrole_key", OperationID: "put", Method: "PUT", Path: "/api/2/applicationrole/{key}"},
+	{Name: "jira_set_comment_property", Legacy: "put_api_2_comment_commentId_properties_propertyKey", OperationID: "", Method: "PUT", Path: "/api/2/comment/{commentId}/properties/{propertyKey}"},
+	{Name: "jira_update_component", Legacy: "put_api_2_component_id", OperationID: "updateComponent", Method: "PUT", Path: "/api/2/component/{id}"},
+	{Name: "jira_set_dashboard_item_property", Legacy: "put_api_2_dashboard_dashboardId_items_itemId_properties_propertyKey", OperationID: "", Method: "PUT", Path: "/api/2/dashboard/{dashboardId}/items/{itemId}/properties/{propertyKey}"},
+	{Name: "jira_set_filter_default_share_scope", Legacy: "put_api_2_filter_defaultShareScope", OperationID: "setDefaultShareScope", Method: "PUT", Path: "/api/2/filter/defaultShareScope"},
+	{Name: "jira_update_filter", Legacy: "put_api_2_filter_id", OperationID: "editFilter", Method: "PUT", Path: "/api/2/filter/{id}"},
+	{Name: "jira_set_filter_columns", Legacy: "put_api_2_filter_id_columns", OperationID: "", Method: "PUT", Path: "/api/2/filter/{id}/columns"},
+	{Name: "jira_update_issue_link_type", Legacy: "put_api_2_issueLinkType_issueLinkTypeId", OperationID: "updateIssueLinkType", Method: "PUT", Path: "/api/2/issueLinkType/{issueLinkTypeId}"},
+	{Name: "jira_edit_issue", Legacy: "put_api_2_issue_issueIdOrKey", OperationID: "editIssue", Method: "PUT", Path: "/api/2/issue/{issueIdOrKey}"},
+	{Name: "jira_assign_issue", Legacy: "put_api_2_issue_issueIdOrKey_assignee", OperationID: "assign", Method: "PUT", Path: "/api/2/issue/{issueIdOrKey}/assignee"},
+	{Name: "jira_update_issue_comment", Legacy: "put_api_2_issue_issueIdOrKey_comment_id", OperationID: "updateComment", Method: "PUT", Path: "/api/2/issue/{issueIdOrKey}/comment/{id}"},
+	{Name: "jira_set_issue_property", Legacy: "put_api_2_issue_issueIdOrKey_properties_propertyKey", OperationID: "", Method: "PUT", Path: "/api/2/issue/{issueIdOrKey}/properties/{propertyKey}"},
+	{Name: "jira_update_remote_issue_link", Legacy: "put_api_2_issue_issueIdOrKey_remotelink_linkId", OperationID: "updateRemoteIssueLink", Method: "PUT", Path: "/api/2/issue/{issueIdOrKey}/remotelink/{linkId}"},
+	{Name: "jira_update_issue_worklog", Legacy: "put_api_2_issue_issueIdOrKey_worklog_id", OperationID: "updateWorklog", Method: "PUT", Path: "/api/2/issue/{issueIdOrKey}/worklog/{id}"},
+	{Name: "jira_update_issue_type", Legacy: "put_api_2_issuetype_id", OperationID: "updateIssueType", Method: "PUT", Path: "/api/2/issuetype/{id}"},
+	{Name: "jira_set_issue_type_property", Legacy: "put_api_2_issuetype_issueTypeId_properties_propertyKey", OperationID: "", Method: "PUT", Path: "/api/2/issuetype/{issueTypeId}/properties/{propertyKey}"},
+	{Name: "jira_set_my_preference", Legacy: "put_api_2_mypreferences", OperationID: "setPreference", Method: "PUT", Path: "/api/2/mypreferences"},
+	{Name: "jira_update_myself", Legacy: "put_api_2_myself", OperationID: "", Method: "PUT", Path: "/api/2/myself"},
+	{Name: "jira_change_my_password", Legacy: "put_api_2_myself_password", OperationID: "changeMyPassword", Method: "PUT", Path: "/api/2/myself/password"},
+	{Name: "jira_set_permission_scheme_attribute", Legacy: "put_api_2_permissionscheme_permissionSchemeId_attribute_key", OperationID: "setSchemeAttribute", Method: "PUT", Path: "/api/2/permissionscheme/{permissionSchemeId}/attribute/{key}"},
+	{Name: "jira_update_permission_scheme", Legacy: "put_api_2_permissionscheme_schemeId", OperationID: "updatePermissionScheme", Method: "PUT", Path: "/api/2/permissionscheme/{schemeId}"},
+	{Name: "jira_update_project_category", Legacy: "put_api_2_projectCategory_id", OperationID: "updateProjectCategory", Method: "PUT", Path: "/api/2/projectCategory/{id}"},
+	{Name: "jira_update_project", Legacy: "put_api_2_project_projectIdOrKey", OperationID: "updateProject", Method: "PUT", Path: "/api/2/project/{projectIdOrKey}"},
+	{Name: "jira_set_project_avatar", Legacy: "put_api_2_project_projectIdOrKey_avatar", OperationID: "", Method: "PUT", Path: "/api/2/project/{projectIdOrKey}/avatar"},
+	{Name: "jira_set_project_property", Legacy: "put_api_2_project_projectIdOrKey_properties_propertyKey", OperationID: "", Method: "PUT", Path: "/api/2/project/{projectIdOrKey}/properties/{propertyKey}"},
+	{Name: "jira_set_project_role_actors", Legacy: "put_api_2_project_projectIdOrKey_role_id", OperationID: "setActors", Method: "PUT", Path: "/api/2/project/{projectIdOrKey}/role/{id}"},
+	{Name: "jira_update_project_type", Legacy: "put_api_2_project_projectIdOrKey_type_newProjectTypeKey", OperationID: "updateProjectType", Method: "PUT", Path: "/api/2/project/{projectIdOrKey}/type/{newProjectTypeKey}"},
+	{Name: "jira_assign_project_permission_scheme", Legacy: "put_api_2_project_projectKeyOrId_permissionscheme", OperationID: "assignPermissionScheme", Method: "PUT", Path: "/api/2/project/{projectKeyOrId}/permissionscheme"},
+	{Name: "jira_update_role", Legacy: "put_api_2_role_id", OperationID: "fullyUpdateProjectRole", Method: "PUT", Path: "/api/2/role/{id}"},
+	{Name: "jira_rename_screen_tab", Legacy: "put_api_2_screens_screenId_tabs_tabId", OperationID: "renameTab", Method: "PUT", Path: "/api/2/screens/{screenId}/tabs/{tabId}"},
+	{Name: "jira_set_base_url", Legacy: "put_api_2_settings_baseUrl", OperationID: "setBaseURL", Method: "PUT", Path: "/api/2/settings/baseUrl"},
+	{Name: "jira_set_issue_navigator_default_columns", Legacy: "put_api_2_settings_columns", OperationID: "setIssueNavigatorDefaultColumns", Method: "PUT", Path: "/api/2/settings/columns"},
+	{Name: "jira_update_user", Legacy: "put_api_2_user", OperationID: "", Method: "PUT", Path: "/api/2/user"},
+	{Name: "jira_set_user_avatar", Legacy: "put_api_2_user_avatar", OperationID: "", Method: "PUT", Path: "/api/2/user/avatar"},
+	{Name: "jira_set_user_columns", Legacy: "put_api_2_user_columns", OperationID: "", Method: "PUT", Path: "/api/2/user/columns"},
+	{Name: "jira_change_user_password", Legacy: "put_api_2_user_password", OperationID: "changeUserPassword", Method: "PUT", Path: "/api/2/user/password"},
+	{Name: "jira_set_user_property", Legacy: "put_api_2_user_properties_propertyKey", OperationID: "", Method: "PUT", Path: "/api/2/user/properties/{propertyKey}"},
+	{Name: "jira_update_version", Legacy: "put_api_2_version_id", OperationID: "updateVersion", Method: "PUT", Path: "/api/2/version/{id}"},
+	{Name: "jira_merge_version", Legacy: "put_api_2_version_id_mergeto_moveIssuesTo", OperationID: "merge", Method: "PUT", Path: "/api/2/version/{id}/mergeto/{moveIssuesTo}"},
+	{Name: "jira_update_workflow_transition_property", Legacy: "put_api_2_workflow_api_2_transitions_id_properties", OperationID: "updateProperty", Method: "PUT", Path: "/api/2/workflow/api/2/transitions/{id}/properties"},
+	{Name: "jira_update_workflow_scheme", Legacy: "put_api_2_workflowscheme_id", OperationID: "update", Method: "PUT", Path: "/api/2/workflowscheme/{id}"},
+	{Name: "jira_update_workflow_scheme_default", Legacy: "put_api_2_workflowscheme_id_default", OperationID: "updateDefault", Method: "PUT", Path: "/api/2/workflowscheme/{id}/default"},
+	{Name: "jira_update_workflow_scheme_draft", Legacy: "put_api_2_workflowscheme_id_draft", OperationID: "updateDraft", Method: "PUT", Path: "/api/2/workflowscheme/{id}/draft"},
+	{Name: "jira_update_workflow_scheme_draft_default", Legacy: "put_api_2_workflowscheme_id_draft_default", OperationID: "updateDraftDefault", Method: "PUT", Path: "/api/2/workflowscheme/{id}/draft/default"},
+	{Name: "jira_set_workflow_scheme_draft_issue_type_mapping", Legacy: "put_api_2_workflowscheme_id_draft_issuetype_issueType", OperationID: "setDraftIssueType", Method: "PUT", Path: "/api/2/workflowscheme/{id}/draft/issuetype/{issueType}"},
+	{Name: "jira_update_workflow_scheme_draft_workflow_mapping", Legacy: "put_api_2_workflowscheme_id_draft_workflow", OperationID: "updateDraftWorkflowMapping", Method: "PUT", Path: "/api/2/workflowscheme/{id}/draft/workflow"},
+	{Name: "jira_set_workflow_scheme_issue_type_mapping", Legacy: "put_api_2_workflowscheme_id_issuetype_issueType", OperationID: "setIssueType", Method: "PUT", Path: "/api/2/workflowscheme/{id}/issuetype/{issueType}"},
+	{Name: "jira_update_workflow_scheme_workflow_mapping", Legacy: "put_api_2_workflowscheme_id_workflow", OperationID: "updateWorkflowMapping", Method: "PUT", Path: "/api/2/workflowscheme/{id}/workflow"},
+}
@@ -0,0 +1,403 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jira-7-6-1/mcp-server/client"
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/jira-7-6-1/mcp-server/operations"
+	tools_api "github.com/jira-7-6-1/mcp-server/tools/api"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// progressPollInterval is how often a waiting tool checks on Jira.
+	progressPollInterval = 5 * time.Second
+	// defaultWaitTimeout bounds a wait when the caller gives no timeout.
+	defaultWaitTimeout = 30 * time.Minute
+	// bulkCreateBatch is the number of issues sent per bulk create request,
+	// Jira's default jira.bulk.create.max.issues.per.request.
+	bulkCreateBatch = 50
+)
+
+// progressStatus is one observation of a running Jira task.
+type progressStatus struct {
+	percent float64
+	message string
+	done    bool
+	result  string // Raw status returned by Jira
+}
+
+// progressPoller reports the state of a task started by a tool call.
+type progressPoller func(ctx context.Context) (progressStatus, error)
+
+// longRunningOp describes how to follow a tool that starts background work.
+type longRunningOp struct {
+	// watch returns a poller for the task the tool call started, given its output.
+	watch func(cfg *config.APIConfig, started string) (progressPoller, error)
+	// cancel, when set, is called if the client cancels while waiting.
+	cancel func(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)
+	// cancelTool names the cancel operation in logs.
+	cancelTool string
+	// doc is appended to the description of the wait parameter.
+	doc string
+}
+
+// longRunningOps are the tools that accept wait=true, keyed by generated name.
+var longRunningOps = map[string]longRunningOp{
+	"post_api_2_reindex": {
+		watch: watchReindex,
+		doc:   "Reports the reindex percentage.",
+	},
+	"post_api_2_cluster_zdu_start": {
+		watch:      watchZDU("READY_TO_RUN_UPGRADE_TASKS"),
+		cancel:     tools_api.CancelupgradeHandler,
+		cancelTool: "jira_cancel_zdu_upgrade",
+		doc:        "Waits until every node runs the new version and reports the share of upgraded nodes. Cancelling the call cancels the upgrade.",
+	},
+	"post_api_2_cluster_zdu_approve": {
+		watch: watchZDU("STABLE"),
+		doc:   "Waits until the upgrade tasks have finished.",
+	},
+	"post_api_2_cluster_zdu_retryUpgrade": {
+		watch: watchZDU("STABLE"),
+		doc:   "Waits until the upgrade tasks have finished.",
+	},
+}
+
+// longRunning adds the wait and timeoutSeconds parameters to the tools in
+// longRunningOps, and an issueUpdates parameter to bulk issue creation,
+// which is sent in batches with progress between them. Synchronous bulk
+// moves report progress when they start and finish. Tools are matched by
+// their generated name, so longRunning runs before friendlyNames.
+func longRunning(tools []models.Tool, cfg *config.APIConfig) []models.Tool {
+	for i := range tools {
+		name := tools[i].Definition.Name
+		if op, ok := longRunningOps[name]; ok {
+			mcp.WithBoolean("wait", mcp.Description("block until Jira finishes, sending notifications/progress when the request has a progress token. "+op.doc))(&tools[i].Definition)
+			mcp.WithNumber("timeoutSeconds", mcp.Description(fmt.Sprintf("how long to wait before returning the last status (default %d)", int(defaultWaitTimeout.Seconds()))))(&tools[i].Definition)
+			tools[i].Handler = waitHandler(cfg, friendlyName(name), op, tools[i].Handler)
+			continue
+		}
+		switch name {
+		case "post_api_2_issue_bulk":
+			mcp.WithArray("issueUpdates",
+				mcp.Description(fmt.Sprintf("issues to create, each {\"fields\": {...}} as for jira_create_issue; sent %d at a time with progress after each batch", bulkCreateBatch)),
+				mcp.Items(map[string]any{"type": "object"}),
+			)(&tools[i].Definition)
+			tools[i].Handler = bulkCreateHandler(cfg, tools[i].Handler)
+		case "put_api_2_version_id_mergeto_moveIssuesTo", "post_api_2_version_id_removeAndSwap":
+			tools[i].Handler = startFinishHandler(tools[i].Handler)
+		}
+	}
+	return tools
+}
+
+// friendlyName returns the stable name of a generated tool for log messages.
+func friendlyName(legacy string) string {
+	if op, ok := operations.ByLegacy(legacy); ok {
+		return op.Name
+	}
+	return legacy
+}
+
+// progressReporter sends notifications/progress for one tool call. It does
+// nothing when the client did not ask for progress.
+type progressReporter struct {
+	ctx   context.Context
+	token mcp.ProgressToken
+}
+
+func newProgressReporter(ctx context.Context, request mcp.CallToolRequest) *progressReporter {
+	r := &progressReporter{ctx: ctx}
+	if request.Params.Meta != nil {
+		r.token = request.Params.Meta.ProgressToken
+	}
+	return r
+}
+
+// report sends the percentage complete, out of 100.
+func (r *progressReporter) report(percent float64, message string) {
+	srv := server.ServerFromContext(r.ctx)
+	if r.token == nil || srv == nil {
+		return
+	}
+	params := map[string]any{
+		"progressToken": r.token,
+		"progress":      percent,
+		"total":         100,
+	}
+	if message != "" {
+		params["message"] = message
+	}
+	if err := srv.SendNotificationToClient(r.ctx, "notifications/progress", params); err != nil {
+		logging.FromContext(r.ctx).Debug("progress notification failed", "error", err)
+	}
+}
+
+// waitHandler runs next and, when the call asks to wait, polls the task it
+// started until it finishes, the timeout passes or the client cancels.
+func waitHandler(cfg *config.APIConfig, name string, op longRunningOp, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if !request.GetBool("wait", false) || client.IsDryRun(ctx) {
+			return next(ctx, request)
+		}
+		timeout := defaultWaitTimeout
+		if seconds := request.GetFloat("timeoutSeconds", 0); seconds > 0 {
+			timeout = time.Duration(seconds * float64(time.Second))
+		}
+		progress := newProgressReporter(ctx, request)
+		progress.report(0, "starting")
+
+		result, err := next(ctx, request)
+		if err != nil || result.IsError {
+			return result, err
+		}
+		poll, err := op.watch(cfg, resultText(result))
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Started, but cannot follow progress", err), nil
+		}
+
+		logger := logging.FromContext(ctx)
+		logger.Info("waiting for jira task", "timeout", timeout.String())
+		deadline := time.NewTimer(timeout)
+		defer deadline.Stop()
+		ticker := time.NewTicker(progressPollInterval)
+		defer ticker.Stop()
+		last := resultText(result)
+		for {
+			status, err := poll(ctx)
+			if err != nil && ctx.Err() == nil {
+				return mcp.NewToolResultErrorFromErr("Failed to check progress", err), nil
+			}
+			if err == nil {
+				last = status.result
+				progress.report(status.percent, status.message)
+				if status.done {
+					logger.Info("jira task finished", "status", status.message)
+					return mcp.NewToolResultText(last), nil
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				logger.Info("wait cancelled by client")
+				if op.cancel != nil {
+					cancelTask(ctx, cfg, op)
+				}
+				return mcp.NewToolResultError("Cancelled while waiting for " + name + "."), nil
+			case <-deadline.C:
+				return mcp.NewToolResultText(fmt.Sprintf("Still running after %s; call %s again without wait or check its progress. Last status:\n%s", timeout, name, last)), nil
+			case <-ticker.C:
+			}
+		}
+	}
+}
+
+// cancelTask calls the cancel operation of op on behalf of a client that
+// cancelled its wait. The call outlives the cancelled request context.
+func cancelTask(ctx context.Context, cfg *config.APIConfig, op longRunningOp) {
+	ctx, stop := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer stop()
+	ctx = logging.WithToolName(ctx, op.cancelTool)
+	if _, err := callTool(ctx, op.cancel(cfg), op.cancelTool, nil); err != nil {
+		logging.FromContext(ctx).Warn("cancelling jira task failed", "error", err)
+		return
+	}
+	logging.FromContext(ctx).Info("jira task cancelled")
+}
+
+// startFinishHandler reports progress around a synchronous call that Jira
+// gives no progress for, such as moving a version's issues to another.
+func startFinishHandler(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		progress := newProgressReporter(ctx, request)
+		progress.report(0, "moving issues")
+		result, err := next(ctx, request)
+		if err == nil && !result.IsError {
+			progress.report(100, "done")
+		}
+		return result, err
+	}
+}
+
+// reindexBean is the part of Jira's reindex status used to follow it.
+type reindexBean struct {
+	ProgressURL     string  `json:"progressUrl"`
+	CurrentProgress float64 `json:"currentProgress"`
+	CurrentSubTask  string  `json:"currentSubTask"`
+	FinishTime      string  `json:"finishTime"`
+	Success         bool    `json:"success"`
+}
+
+// watchReindex follows the reindex task named by the progressUrl of the
+// response to jira_start_reindex.
+func watchReindex(cfg *config.APIConfig, started string) (progressPoller, error) {
+	var bean reindexBean
+	if err := json.Unmarshal([]byte(started), &bean); err != nil {
+		return nil, fmt.Errorf("decode reindex response: %w", err)
+	}
+	args := map[string]any{}
+	tool, handler := "jira_get_reindex_info", tools_api.GetreindexinfoHandler(cfg)
+	if u, err := url.Parse(bean.ProgressURL); err == nil && u.Query().Get("taskId") != "" {
+		args["taskId"] = u.Query().Get("taskId")
+		tool, handler = "jira_get_reindex_progress", tools_api.GetreindexprogressHandler(cfg)
+	}
+
+	return func(ctx context.Context) (progressStatus, error) {
+		text, err := callTool(logging.WithToolName(ctx, tool), handler, tool, args)
+		if err != nil {
+			return progressStatus{}, err
+		}
+		var bean reindexBean
+		if err := json.Unmarshal([]byte(text), &bean); err != nil {
+			return progressStatus{}, fmt.Errorf("decode reindex progress: %w", err)
+		}
+		status := progressStatus{percent: bean.CurrentProgress, message: bean.CurrentSubTask, result: text}
+		if bean.FinishTime != "" {
+			status.done = true
+			status.percent = 100
+			status.message = "reindex finished"
+			if !bean.Success {
+				return status, fmt.Errorf("reindex failed: %s", text)
+			}
+		}
+		return status, nil
+	}, nil
+}
+
+// zduState is the part of the cluster upgrade state used to follow it.
+type zduState struct {
+	State     string `json:"state"`
+	BuildInfo struct {
+		BuildNumber json.Number `json:"buildNumber"`
+	} `json:"buildInfo"`
+	Nodes []struct {
+		NodeBuildInfo struct {
+			BuildNumber json.Number `json:"buildNumber"`
+		} `json:"nodeBuildInfo"`
+	} `json:"nodes"`
+}
+
+// zduMilestones approximates how far each upgrade state is from STABLE.
+var zduMilestones = map[string]float64{
+	"READY_TO_UPGRADE":           5,
+	"MIXED":                      10,
+	"READY_TO_RUN_UPGRADE_TASKS": 90,
+	"RUNNING_UPGRADE_TASKS":      95,
+	"STABLE":                     100,
+}
+
+// watchZDU returns a watcher that polls the cluster upgrade state until it
+// reaches target. While nodes are being upgraded the progress is the share
+// of nodes running a newer build than the cluster.
+func watchZDU(target string) func(cfg *config.APIConfig, started string) (progressPoller, error) {
+	return func(cfg *config.APIConfig, started string) (progressPoller, error) {
+		handler := tools_api.GetstateHandler(cfg)
+		const tool = "jira_get_zdu_state"
+		return func(ctx context.Context) (progressStatus, error) {
+			text, err := callTool(logging.WithToolName(ctx, tool), handler, tool, nil)
+			if err != nil {
+				return progressStatus{}, err
+			}
+			var state zduState
+			if err := json.Unmarshal([]byte(text), &state); err != nil {
+				return progressStatus{}, fmt.Errorf("decode upgrade state: %w", err)
+			}
+			if strings.Contains(state.State, "FAILED") {
+				return progressStatus{}, fmt.Errorf("upgrade is in state %s", state.State)
+			}
+
+			status := progressStatus{message: state.State, result: text, done: state.State == target}
+			status.percent = zduMilestones[state.State]
+			if state.State == "MIXED" && len(state.Nodes) > 0 {
+				cluster, _ := strconv.ParseInt(state.BuildInfo.BuildNumber.String(), 10, 64)
+				upgraded := 0
+				for _, node := range state.Nodes {
+					if build, _ := strconv.ParseInt(node.NodeBuildInfo.BuildNumber.String(), 10, 64); build > cluster {
+						upgraded++
+					}
+				}
+				status.percent = 10 + 80*float64(upgraded)/float64(len(state.Nodes))
+				status.message = fmt.Sprintf("MIXED: %d of %d nodes upgraded", upgraded, len(state.Nodes))
+			}
+			if status.done {
+				status.percent = 100
+			}
+			return status, nil
+		}, nil
+	}
+}
+
+// bulkCreateResult is Jira's response to a bulk create.
+type bulkCreateResult struct {
+	Issues []json.RawMessage `json:"issues"`
+	Errors []bulkCreateError `json:"errors"`
+}
+
+type bulkCreateError struct {
+	Status              int             `json:"status"`
+	ElementErrors       json.RawMessage `json:"elementErrors"`
+	FailedElementNumber int             `json:"failedElementNumber"`
+}
+
+// bulkCreateHandler sends issueUpdates in batches of bulkCreateBatch,
+// reporting progress after each batch and stopping between batches when the
+// client cancels. Without issueUpdates the generated handler is used.
+func bulkCreateHandler(cfg *config.APIConfig, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		updates, _ := request.GetArguments()["issueUpdates"].([]any)
+		if len(updates) == 0 {
+			return next(ctx, request)
+		}
+		progress := newProgressReporter(ctx, request)
+		progress.report(0, fmt.Sprintf("creating %d issues", len(updates)))
+
+		var combined bulkCreateResult
+		combined.Issues = []json.RawMessage{}
+		combined.Errors = []bulkCreateError{}
+		sent := 0
+		for sent < len(updates) {
+			if ctx.Err() != nil {
+				logging.FromContext(ctx).Info("bulk create cancelled by client", "created", len(combined.Issues), "remaining", len(updates)-sent)
+				break
+			}
+			batch := updates[sent:min(sent+bulkCreateBatch, len(updates))]
+			data, err := jiraRequest(ctx, cfg, "POST", "/api/2/issue/bulk", map[string]any{"issueUpdates": batch})
+			var result bulkCreateResult
+			if jsonErr := json.Unmarshal(data, &result); jsonErr != nil {
+				if err == nil {
+					err = jsonErr
+				}
+				return mcp.NewToolResultErrorFromErr(fmt.Sprintf("Bulk create failed after %d of %d issues", sent, len(updates)), err), nil
+			}
+			combined.Issues = append(combined.Issues, result.Issues...)
+			for _, e := range result.Errors {
+				e.FailedElementNumber += sent // Number relative to the whole input
+				combined.Errors = append(combined.Errors, e)
+			}
+			sent += len(batch)
+			progress.report(100*float64(sent)/float64(len(updates)), fmt.Sprintf("%d of %d issues sent", sent, len(updates)))
+		}
+
+		out := map[string]any{"issues": combined.Issues, "errors": combined.Errors}
+		if sent < len(updates) {
+			out["cancelled"] = true
+			out["notSent"] = len(updates) - sent
+		}
+		pretty, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+		}
+		return mcp.NewToolResultText(string(pretty)), nil
+	}
+}
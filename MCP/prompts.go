@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	tools_api "github.com/jira-7-6-1/mcp-server/tools/api"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Fields requested when a prompt embeds search results. Keeping the list
+// short keeps the prompt within the model's context.
+const (
+	issueListFields = "summary,issuetype,status,resolution,priority,assignee,components,labels,created,updated,resolutiondate"
+	triageFields    = "summary,description,issuetype,priority,status,components,labels,reporter,assignee,created,updated,versions,fixVersions,environment"
+)
+
+// addPrompts registers the workflow prompts on s. Each prompt pre-fetches
+// the Jira data it needs through the tool handlers and embeds it.
+func addPrompts(s *server.MCPServer, cfg *config.APIConfig) {
+	s.AddPrompt(mcp.NewPrompt("triage_issue",
+		mcp.WithPromptDescription("Triage an issue: classify it, suggest priority, components and labels, and list missing information."),
+		mcp.WithArgument("issueKey", mcp.RequiredArgument(), mcp.ArgumentDescription("issue key or id, e.g. PROJ-123")),
+	), triageIssuePrompt(cfg))
+
+	s.AddPrompt(mcp.NewPrompt("write_bug_report",
+		mcp.WithPromptDescription("Turn rough notes into a complete bug report that satisfies the project's required fields."),
+		mcp.WithArgument("projectKey", mcp.RequiredArgument(), mcp.ArgumentDescription("key of the project to file the bug in")),
+		mcp.WithArgument("notes", mcp.RequiredArgument(), mcp.ArgumentDescription("what went wrong, in any form")),
+	), writeBugReportPrompt(cfg))
+
+	s.AddPrompt(mcp.NewPrompt("release_notes_for_version",
+		mcp.WithPromptDescription("Draft release notes from the issues fixed in a version."),
+		mcp.WithArgument("versionId", mcp.RequiredArgument(), mcp.ArgumentDescription("id of the version (release)")),
+	), releaseNotesPrompt(cfg))
+
+	s.AddPrompt(mcp.NewPrompt("standup_summary",
+		mcp.WithPromptDescription("Summarise recent work on assigned issues as a stand-up update."),
+		mcp.WithArgument("username", mcp.ArgumentDescription("whose issues to summarise; defaults to the current user")),
+		mcp.WithArgument("projectKey", mcp.ArgumentDescription("limit to one project")),
+		mcp.WithArgument("days", mcp.ArgumentDescription("how many days back to look (default 1)")),
+	), standupPrompt(cfg))
+
+	s.AddPrompt(mcp.NewPrompt("sprint_retro_from_jql",
+		mcp.WithPromptDescription("Prepare a sprint retrospective from the issues matched by a JQL query."),
+		mcp.WithArgument("jql", mcp.RequiredArgument(), mcp.ArgumentDescription("JQL selecting the sprint's issues, e.g. sprint = 42")),
+	), sprintRetroPrompt(cfg))
+}
+
+func triageIssuePrompt(cfg *config.APIConfig) server.PromptHandlerFunc {
+	getIssue := tools_api.GetissueHandler(cfg)
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		key, err := promptArgument(request, "issueKey")
+		if err != nil {
+			return nil, err
+		}
+		issue, err := prefetch(ctx, cfg, getIssue, "jira_get_issue", map[string]any{"issueIdOrKey": key, "fields": triageFields})
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewGetPromptResult("Triage "+key, []mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleUser, jsonResource(issueURIPrefix+key, issue)),
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(fmt.Sprintf(`Triage Jira issue %s using the issue data above.
+
+1. Summarise the problem in at most two sentences.
+2. Classify it as a bug, feature request, task or support question, and say whether the issue type fits.
+3. Suggest a priority with a one-line justification.
+4. Suggest components and labels.
+5. List information the reporter still needs to provide, such as steps to reproduce, expected and actual behaviour, environment or affected versions.
+6. Suggest a JQL query to look for duplicates.
+
+Do not change the issue. Propose the updates and wait for confirmation.`, key))),
+		}), nil
+	}
+}
+
+func writeBugReportPrompt(cfg *config.APIConfig) server.PromptHandlerFunc {
+	createMeta := tools_api.GetcreateissuemetaHandler(cfg)
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		project, err := promptArgument(request, "projectKey")
+		if err != nil {
+			return nil, err
+		}
+		notes, err := promptArgument(request, "notes")
+		if err != nil {
+			return nil, err
+		}
+		meta, err := prefetch(ctx, cfg, createMeta, "jira_get_create_issue_meta", map[string]any{
+			"projectKeys":    project,
+			"issuetypeNames": "Bug",
+			"expand":         "projects.issuetypes.fields",
+		})
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewGetPromptResult("Bug report for "+project, []mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleUser, jsonText("Create metadata for Bug issues in "+project, meta)),
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(fmt.Sprintf(`Write a bug report for project %s from the notes below.
+
+- Keep the summary under 80 characters and specific to the symptom.
+- Write the description in Markdown with the sections **Steps to reproduce** (numbered), **Expected result**, **Actual result**, **Environment** and **Notes**; jira_create_issue converts it to wiki markup.
+- Fill every field the create metadata above marks as required; use only allowed values.
+- Say which details are missing from the notes instead of inventing them.
+
+Finish with the arguments for jira_create_issue as JSON, with other fields by display name under fields, and ask before creating it.
+
+Notes:
+%s`, project, notes))),
+		}), nil
+	}
+}
+
+func releaseNotesPrompt(cfg *config.APIConfig) server.PromptHandlerFunc {
+	getVersion := tools_api.GetversionHandler(cfg)
+	search := tools_api.SearchHandler(cfg)
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		id, err := promptArgument(request, "versionId")
+		if err != nil {
+			return nil, err
+		}
+		version, err := prefetch(ctx, cfg, getVersion, "jira_get_version", map[string]any{"id": id})
+		if err != nil {
+			return nil, err
+		}
+		jql := fmt.Sprintf("fixVersion = %s ORDER BY issuetype ASC, key ASC", jqlString(id))
+		issues, err := prefetch(ctx, cfg, search, "jira_search_issues", map[string]any{"jql": jql, "fields": issueListFields, "maxResults": 500})
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewGetPromptResult("Release notes for version "+id, []mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleUser, jsonResource("jira://version/"+id, version)),
+			mcp.NewPromptMessage(mcp.RoleUser, jsonText("Issues with this fix version ("+jql+")", issues)),
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(`Write release notes for the version above in Markdown.
+
+- Start with a two-sentence overview of the release.
+- Group resolved issues under *New features*, *Improvements* and *Bug fixes*, one line per issue with its key.
+- Rewrite summaries for end users; leave out internal tasks and sub-tasks.
+- List unresolved issues separately as known issues.`)),
+		}), nil
+	}
+}
+
+func standupPrompt(cfg *config.APIConfig) server.PromptHandlerFunc {
+	search := tools_api.SearchHandler(cfg)
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		args := request.Params.Arguments
+		assignee := "currentUser()"
+		if user := strings.TrimSpace(args["username"]); user != "" {
+			assignee = jqlString(user)
+		}
+		days := 1
+		if d := strings.TrimSpace(args["days"]); d != "" {
+			n, err := strconv.Atoi(d)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("days must be a positive number, got %q", d)
+			}
+			days = n
+		}
+		jql := fmt.Sprintf("assignee = %s AND updated >= -%dd", assignee, days)
+		if project := strings.TrimSpace(args["projectKey"]); project != "" {
+			jql += " AND project = " + jqlString(project)
+		}
+		jql += " ORDER BY updated DESC"
+
+		issues, err := prefetch(ctx, cfg, search, "jira_search_issues", map[string]any{"jql": jql, "fields": issueListFields, "maxResults": 100})
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewGetPromptResult("Stand-up summary", []mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleUser, jsonText("Recently updated issues ("+jql+")", issues)),
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(`Write a short stand-up update from the issues above with three sections:
+
+- *Done*: issues resolved in the period.
+- *In progress*: issues still open, with their current status.
+- *Blockers*: issues that look stuck or blocked, and why.
+
+Mention issue keys and keep each line under 20 words.`)),
+		}), nil
+	}
+}
+
+func sprintRetroPrompt(cfg *config.APIConfig) server.PromptHandlerFunc {
+	search := tools_api.SearchHandler(cfg)
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		jql, err := promptArgument(request, "jql")
+		if err != nil {
+			return nil, err
+		}
+		issues, err := prefetch(ctx, cfg, search, "jira_search_issues", map[string]any{"jql": jql, "fields": issueListFields, "maxResults": 200})
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewGetPromptResult("Sprint retrospective", []mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleUser, jsonText("Sprint issues ("+jql+")", issues)),
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(`Prepare a sprint retrospective from the issues above.
+
+1. Give the numbers: issues completed versus not completed, split by issue type, and the median time from created to resolved.
+2. What went well, backed by specific issues.
+3. What did not go well: carry-over, reopened or long-running issues, and bugs found late.
+4. Suggest at most three concrete action items for the next sprint.`)),
+		}), nil
+	}
+}
+
+// promptArgument returns a required, non-empty prompt argument.
+func promptArgument(request mcp.GetPromptRequest, name string) (string, error) {
+	value := strings.TrimSpace(request.Params.Arguments[name])
+	if value == "" {
+		return "", fmt.Errorf("missing required argument %q", name)
+	}
+	return value, nil
+}
+
+// prefetch calls a tool handler on behalf of a prompt. The output is
+// redacted and truncated like a tool result, since prompts embed it whole.
+func prefetch(ctx context.Context, cfg *config.APIConfig, handler server.ToolHandlerFunc, tool string, args map[string]any) (string, error) {
+	ctx = logging.WithRequestID(ctx, logging.NewRequestID())
+	ctx = logging.WithToolName(ctx, tool)
+	text, err := callTool(ctx, handler, tool, args)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", tool, err)
+	}
+	return limitText(ctx, text, cfg.MaxResponseBytes), nil
+}
+
+// jsonResource embeds tool output as a resource so clients can show where
+// it came from.
+func jsonResource(uri, text string) mcp.Content {
+	return mcp.NewEmbeddedResource(mcp.TextResourceContents{URI: uri, MIMEType: "application/json", Text: text})
+}
+
+// jsonText embeds tool output that has no resource URI, such as search results.
+func jsonText(title, text string) mcp.Content {
+	return mcp.NewTextContent(title + ":\n\n```json\n" + text + "\n```")
+}
+
+// jqlString quotes s as a JQL string literal.
+func jqlString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -5,9 +5,40 @@ import (
 	"github.com/jira-7-6-1/mcp-server/models"
 	tools_api "github.com/jira-7-6-1/mcp-server/tools/api"
 	tools_auth "github.com/jira-7-6-1/mcp-server/tools/auth"
+	tools_meta "github.com/jira-7-6-1/mcp-server/tools/meta"
 )
 
+// GetAll returns the tools to register for cfg: the generated tools with
+// hand-written issue tools swapped in, filtered by group, name and read-only
+// mode, and wrapped with progress, confirmation, markup, formatting and dry-run
+// support.
 func GetAll(cfg *config.APIConfig) []models.Tool {
+	tools := filterTools(issueTools(allTools(cfg), cfg), cfg)
+	if cfg.ReadOnly {
+		tools = filterReadOnly(tools)
+	}
+	tools = confirmDestructive(longRunning(tools, cfg), cfg)
+	return annotate(friendlyNames(dryRunTools(formatTools(markupTools(tools, cfg)), cfg)))
+}
+
+// GetMeta returns the discovery meta-tools used in lazy mode. They search,
+// describe and invoke the given tools instead of registering them directly.
+func GetMeta(tools []models.Tool) []models.Tool {
+	catalog := tools_meta.NewCatalog(tools)
+	return []models.Tool{
+		tools_meta.CreateSearchoperationsTool(catalog),
+		tools_meta.CreateDescribeoperationTool(catalog),
+		tools_meta.CreateInvokeoperationTool(catalog),
+	}
+}
+
+// GetDocs returns describe_jira_operation on its own so that the full
+// documentation trimmed from tool descriptions stays available outside lazy mode.
+func GetDocs(tools []models.Tool) models.Tool {
+	return tools_meta.CreateDescribeoperationTool(tools_meta.NewCatalog(tools))
+}
+
+func allTools(cfg *config.APIConfig) []models.Tool {
 	return []models.Tool{
 		tools_api.CreateGetissuelinktypesTool(cfg),
 		tools_api.CreateCreateissuelinktypeTool(cfg),
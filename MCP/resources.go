@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	tools_api "github.com/jira-7-6-1/mcp-server/tools/api"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// jiraResource exposes a read-only tool as an addressable resource, e.g.
+// jira://issue/PROJ-1 is read through jira_get_issue.
+type jiraResource struct {
+	uriTemplate string
+	name        string
+	description string
+	variable    string // Template variable holding the identifier
+	argument    string // Tool argument the identifier is passed as
+	tool        string // Tool name used in logs
+	handler     func(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)
+}
+
+var jiraResources = []jiraResource{
+	{
+		uriTemplate: "jira://issue/{key}",
+		name:        "Jira issue",
+		description: "An issue with all its fields, addressed by key (PROJ-123) or id.",
+		variable:    "key",
+		argument:    "issueIdOrKey",
+		tool:        "jira_get_issue",
+		handler:     tools_api.GetissueHandler,
+	},
+	{
+		uriTemplate: "jira://project/{key}",
+		name:        "Jira project",
+		description: "A project with its components, versions and issue types, addressed by key or id.",
+		variable:    "key",
+		argument:    "projectIdOrKey",
+		tool:        "jira_get_project",
+		handler:     tools_api.Get_api_2_project_projectidorkeyHandler,
+	},
+	{
+		uriTemplate: "jira://filter/{id}",
+		name:        "Jira filter",
+		description: "A saved filter with its JQL, addressed by id.",
+		variable:    "id",
+		argument:    "id",
+		tool:        "jira_get_filter",
+		handler:     tools_api.GetfilterHandler,
+	},
+	{
+		uriTemplate: "jira://dashboard/{id}",
+		name:        "Jira dashboard",
+		description: "A dashboard, addressed by id.",
+		variable:    "id",
+		argument:    "id",
+		tool:        "jira_get_dashboard",
+		handler:     tools_api.GetdashboardHandler,
+	},
+	{
+		uriTemplate: "jira://version/{id}",
+		name:        "Jira version",
+		description: "A project version (release), addressed by id.",
+		variable:    "id",
+		argument:    "id",
+		tool:        "jira_get_version",
+		handler:     tools_api.GetversionHandler,
+	},
+}
+
+// addResources registers the jira:// resource templates on s.
+func addResources(s *server.MCPServer, cfg *config.APIConfig) {
+	for _, r := range jiraResources {
+		template := mcp.NewResourceTemplate(r.uriTemplate, r.name,
+			mcp.WithTemplateDescription(r.description),
+			mcp.WithTemplateMIMEType("application/json"),
+		)
+		s.AddResourceTemplate(template, readResource(r, r.handler(cfg), cfg.MaxResponseBytes))
+	}
+}
+
+// readResource adapts a tool handler to a resource template handler. Like
+// tool results, the text is redacted and cut to limit bytes.
+func readResource(r jiraResource, handler server.ToolHandlerFunc, limit int) server.ResourceTemplateHandlerFunc {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		id := templateValue(request.Params.Arguments[r.variable])
+		if id == "" {
+			return nil, fmt.Errorf("%s: missing %s", request.Params.URI, r.variable)
+		}
+
+		ctx = logging.WithRequestID(ctx, logging.NewRequestID())
+		ctx = logging.WithToolName(ctx, r.tool)
+		logging.FromContext(ctx).Debug("resource read", "uri", request.Params.URI)
+
+		text, err := callTool(ctx, handler, r.tool, map[string]any{r.argument: id})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", request.Params.URI, err)
+		}
+		text = limitText(ctx, text, limit)
+		return []mcp.ResourceContents{
+			mcp.TextResourceContents{URI: request.Params.URI, MIMEType: "application/json", Text: text},
+		}, nil
+	}
+}
+
+// callTool invokes a tool handler directly and returns its text output. A
+// tool error result is turned into an error.
+func callTool(ctx context.Context, handler server.ToolHandlerFunc, name string, args map[string]any) (string, error) {
+	var request mcp.CallToolRequest
+	request.Params.Name = name
+	request.Params.Arguments = args
+
+	result, err := handler(ctx, request)
+	if err != nil {
+		return "", err
+	}
+	text := resultText(result)
+	if result.IsError {
+		return "", errors.New(logging.RedactSecrets(ctx, text))
+	}
+	return text, nil
+}
+
+// resultText joins the text content of a tool result.
+func resultText(result *mcp.CallToolResult) string {
+	var text string
+	for _, content := range result.Content {
+		if c, ok := content.(mcp.TextContent); ok {
+			text += c.Text
+		}
+	}
+	return text
+}
+
+// templateValue unwraps a URI template variable, which the server passes
+// as a list of strings.
+func templateValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []string:
+		if len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
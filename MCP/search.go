@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// compactFields are the columns of the compact search profile, in order.
+var compactFields = []string{"summary", "status", "assignee", "priority", "updated"}
+
+// noiseKeys are dropped from search results: links back into the REST API,
+// avatar and icon URLs, and expansion hints.
+var noiseKeys = map[string]bool{
+	"self":       true,
+	"avatarUrls": true,
+	"iconUrl":    true,
+	"expand":     true,
+}
+
+func searchTool(cfg *config.APIConfig) models.Tool {
+	tool := mcp.NewTool("jira_search",
+		mcp.WithDescription("Searches issues with JQL and returns them without the noise of jira_search_issues: no rendered HTML, avatar URLs or self links, and custom fields under their display names. The compact profile returns one flat row per issue with key, summary, status, assignee, priority, updated and the requested fields; the full profile returns every field, cleaned the same way."),
+		mcp.WithString("jql", mcp.Required(), mcp.Description("the JQL query, e.g. project = ABC AND status = \"In Progress\"")),
+		mcp.WithArray("fields", mcp.Description("more fields to return by display name or id, e.g. [\"Story Points\", \"Epic Link\", \"labels\"]"), mcp.WithStringItems()),
+		mcp.WithString("profile", mcp.Description("compact (default) or full"), mcp.Enum("compact", "full")),
+		mcp.WithNumber("startAt", mcp.Description("index of the first issue to return (default 0)")),
+		mcp.WithNumber("maxResults", mcp.Description("issues to return (default 50)")),
+	)
+	return models.Tool{Definition: tool, Handler: searchHandler(cfg)}
+}
+
+// searchPage is the result of jira_search.
+type searchPage struct {
+	Total      int              `json:"total"`
+	StartAt    int              `json:"startAt"`
+	MaxResults int              `json:"maxResults"`
+	Issues     []map[string]any `json:"issues"`
+}
+
+func searchHandler(cfg *config.APIConfig) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		jql, err := request.RequireString("jql")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		full := request.GetString("profile", "compact") == "full"
+
+		var fields []jiraField
+		if err := cachedGet(ctx, cfg, "/api/2/field", &fields); err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to get fields", err), nil
+		}
+		names := make(map[string]string, len(fields))
+		for _, f := range fields {
+			names[f.ID] = f.Name
+		}
+		requested, problems := fieldIDs(fields, request.GetStringSlice("fields", nil))
+		if len(problems) > 0 {
+			return mcp.NewToolResultError(strings.Join(problems, "\n")), nil
+		}
+
+		query := url.Values{
+			"jql":        {jql},
+			"startAt":    {fmt.Sprint(request.GetInt("startAt", 0))},
+			"maxResults": {fmt.Sprint(request.GetInt("maxResults", 50))},
+		}
+		if !full {
+			query.Set("fields", strings.Join(append(append([]string{}, compactFields...), requested...), ","))
+		}
+		var result struct {
+			Total      int `json:"total"`
+			StartAt    int `json:"startAt"`
+			MaxResults int `json:"maxResults"`
+			Issues     []struct {
+				Key    string         `json:"key"`
+				Fields map[string]any `json:"fields"`
+			} `json:"issues"`
+		}
+		if err := getJSON(ctx, cfg, "/api/2/search?"+query.Encode(), &result); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		page := searchPage{Total: result.Total, StartAt: result.StartAt, MaxResults: result.MaxResults, Issues: []map[string]any{}}
+		for _, issue := range result.Issues {
+			row := map[string]any{"key": issue.Key}
+			if full {
+				for id, value := range issue.Fields {
+					if value = cleanValue(value); value != nil {
+						row[displayName(names, id)] = value
+					}
+				}
+			} else {
+				for _, id := range compactFields {
+					row[id] = displayValue(issue.Fields[id])
+				}
+				for _, id := range requested {
+					row[displayName(names, id)] = displayValue(cleanValue(issue.Fields[id]))
+				}
+			}
+			page.Issues = append(page.Issues, row)
+		}
+
+		pretty, err := json.MarshalIndent(page, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+		}
+		return mcp.NewToolResultText(string(pretty)), nil
+	}
+}
+
+// fieldIDs resolves field display names or ids against the field list.
+func fieldIDs(fields []jiraField, requested []string) ([]string, []string) {
+	var ids, problems []string
+	for _, name := range requested {
+		var matches []string
+		for _, f := range fields {
+			if f.ID == name || strings.EqualFold(f.Name, name) {
+				matches = appendUnique(matches, f.ID)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			var all []string
+			for _, f := range fields {
+				all = append(all, f.Name)
+			}
+			problem := fmt.Sprintf("unknown field %q", name)
+			if similar := similarNames(name, all); len(similar) > 0 {
+				problem += "; did you mean " + strings.Join(similar, ", ") + "?"
+			}
+			problems = append(problems, problem)
+		case 1:
+			ids = appendUnique(ids, matches[0])
+		default:
+			problems = append(problems, fmt.Sprintf("field %q is ambiguous, use one of the ids %s", name, strings.Join(matches, ", ")))
+		}
+	}
+	return ids, problems
+}
+
+// displayName returns the name of a field for output: system fields keep
+// their id, custom fields get their display name.
+func displayName(names map[string]string, id string) string {
+	if name, ok := names[id]; ok && strings.HasPrefix(id, "customfield_") {
+		return name
+	}
+	return id
+}
+
+// cleanValue drops noiseKeys and empty values from a field value. It
+// returns nil for a value that is empty after cleaning.
+func cleanValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, inner := range v {
+			if noiseKeys[key] {
+				continue
+			}
+			if inner = cleanValue(inner); inner != nil {
+				out[key] = inner
+			}
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		return out
+	case []any:
+		out := make([]any, 0, len(v))
+		for _, item := range v {
+			if item = cleanValue(item); item != nil {
+				out = append(out, item)
+			}
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		return out
+	case string:
+		if v == "" {
+			return nil
+		}
+	}
+	return v
+}
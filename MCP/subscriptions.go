@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	tools_api "github.com/jira-7-6-1/mcp-server/tools/api"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const issueURIPrefix = "jira://issue/"
+
+// jiraTimeLayout is the timestamp format of Jira issue fields.
+const jiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// subscription is the state of one subscribed resource URI.
+type subscription struct {
+	sessions map[string]bool
+	seen     time.Time // Latest update already notified, or the subscribe time
+}
+
+// watcher polls Jira for changes to subscribed issue resources and sends
+// notifications/resources/updated to the sessions that subscribed to them.
+// Only jira://issue/{key} resources are polled; subscribing to other
+// resources is accepted but never notified.
+type watcher struct {
+	ctx      context.Context // Ends polling when the server shuts down
+	server   *server.MCPServer
+	search   server.ToolHandlerFunc
+	interval time.Duration
+	batch    int
+
+	mu       sync.Mutex
+	subs     map[string]*subscription
+	lastPoll time.Time
+	start    sync.Once
+}
+
+// newWatcher returns a watcher for cfg that polls until ctx is done. Its
+// server must be set before the first subscription arrives.
+func newWatcher(ctx context.Context, cfg *config.APIConfig) *watcher {
+	return &watcher{
+		ctx:      ctx,
+		search:   tools_api.SearchHandler(cfg),
+		interval: cfg.PollInterval,
+		batch:    cfg.PollBatchSize,
+		subs:     map[string]*subscription{},
+	}
+}
+
+// addHooks adds the server hooks that keep the watcher in sync with the
+// subscriptions of every session.
+func (w *watcher) addHooks(hooks *server.Hooks) {
+	hooks.AddAfterSubscribe(func(ctx context.Context, id any, message *mcp.SubscribeRequest, result *mcp.EmptyResult) {
+		if session := server.ClientSessionFromContext(ctx); session != nil {
+			w.subscribe(session.SessionID(), message.Params.URI)
+		}
+	})
+	hooks.AddAfterUnsubscribe(func(ctx context.Context, id any, message *mcp.UnsubscribeRequest, result *mcp.EmptyResult) {
+		if session := server.ClientSessionFromContext(ctx); session != nil {
+			w.unsubscribe(session.SessionID(), message.Params.URI)
+		}
+	})
+	hooks.AddOnUnregisterSession(func(ctx context.Context, session server.ClientSession) {
+		w.dropSession(session.SessionID())
+	})
+}
+
+func (w *watcher) subscribe(sessionID, uri string) {
+	w.mu.Lock()
+	sub, ok := w.subs[uri]
+	if !ok {
+		sub = &subscription{sessions: map[string]bool{}, seen: time.Now()}
+		w.subs[uri] = sub
+	}
+	sub.sessions[sessionID] = true
+	w.mu.Unlock()
+
+	if !strings.HasPrefix(uri, issueURIPrefix) {
+		slog.Warn("resource subscription is not polled", "uri", uri)
+		return
+	}
+	w.start.Do(func() { go w.run() })
+}
+
+func (w *watcher) unsubscribe(sessionID, uri string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if sub, ok := w.subs[uri]; ok {
+		delete(sub.sessions, sessionID)
+		if len(sub.sessions) == 0 {
+			delete(w.subs, uri)
+		}
+	}
+}
+
+func (w *watcher) dropSession(sessionID string) {
+	w.mu.Lock()
+	var uris []string
+	for uri, sub := range w.subs {
+		if sub.sessions[sessionID] {
+			uris = append(uris, uri)
+		}
+	}
+	w.mu.Unlock()
+	for _, uri := range uris {
+		w.unsubscribe(sessionID, uri)
+	}
+}
+
+func (w *watcher) run() {
+	w.mu.Lock()
+	w.lastPoll = time.Now()
+	w.mu.Unlock()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.ctx.Done():
+			slog.Debug("subscription polling stopped")
+			return
+		case <-ticker.C:
+			w.poll(w.ctx)
+		}
+	}
+}
+
+// poll searches for subscribed issues updated since the previous poll, in
+// batches of w.batch keys, and notifies their subscribers. It stops between
+// batches once ctx is done.
+func (w *watcher) poll(ctx context.Context) {
+	w.mu.Lock()
+	var keys []string
+	for uri := range w.subs {
+		if key, ok := strings.CutPrefix(uri, issueURIPrefix); ok && key != "" {
+			keys = append(keys, key)
+		}
+	}
+	// Jira only understands relative dates to the minute; overlap by one
+	// minute and rely on the seen timestamps to suppress duplicates.
+	minutes := int(math.Ceil(time.Since(w.lastPoll).Minutes())) + 1
+	w.lastPoll = time.Now()
+	w.mu.Unlock()
+	if len(keys) == 0 {
+		return
+	}
+	sort.Strings(keys)
+
+	ctx = logging.WithRequestID(ctx, logging.NewRequestID())
+	ctx = logging.WithToolName(ctx, "jira_search_issues")
+	for start := 0; start < len(keys) && ctx.Err() == nil; start += w.batch {
+		batch := keys[start:min(start+w.batch, len(keys))]
+		updates, err := w.updatedIssues(ctx, batch, minutes)
+		if err != nil {
+			logging.FromContext(ctx).Warn("subscription poll failed", "issues", len(batch), "error", err)
+			continue
+		}
+		for _, u := range updates {
+			w.notify(ctx, issueURIPrefix+u.Key, u.Updated)
+			w.notify(ctx, issueURIPrefix+u.ID, u.Updated)
+		}
+	}
+}
+
+type issueUpdate struct {
+	ID      string
+	Key     string
+	Updated time.Time
+}
+
+func (w *watcher) updatedIssues(ctx context.Context, keys []string, minutes int) ([]issueUpdate, error) {
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = `"` + strings.ReplaceAll(key, `"`, "") + `"`
+	}
+	jql := fmt.Sprintf("issue in (%s) AND updated >= -%dm", strings.Join(quoted, ", "), minutes)
+
+	text, err := callTool(ctx, w.search, "jira_search_issues", map[string]any{
+		"jql":        jql,
+		"fields":     "updated",
+		"maxResults": len(keys),
+		// A deleted or moved issue would otherwise fail the whole batch with a
+		// 400. Jira 7.6 takes a boolean here; "warn" came in later versions.
+		"validateQuery": "false",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Issues []struct {
+			ID     string `json:"id"`
+			Key    string `json:"key"`
+			Fields struct {
+				Updated string `json:"updated"`
+			} `json:"fields"`
+		} `json:"issues"`
+	}
+	if err := json.Unmarshal([]byte(text), &result); err != nil {
+		return nil, fmt.Errorf("decode search result: %w", err)
+	}
+
+	updates := make([]issueUpdate, 0, len(result.Issues))
+	for _, issue := range result.Issues {
+		updated, err := time.Parse(jiraTimeLayout, issue.Fields.Updated)
+		if err != nil {
+			updated = time.Now()
+		}
+		updates = append(updates, issueUpdate{ID: issue.ID, Key: issue.Key, Updated: updated})
+	}
+	return updates, nil
+}
+
+// notify sends notifications/resources/updated for uri to its subscribers
+// unless the update was already reported.
+func (w *watcher) notify(ctx context.Context, uri string, updated time.Time) {
+	w.mu.Lock()
+	sub, ok := w.subs[uri]
+	if !ok || !updated.After(sub.seen) {
+		w.mu.Unlock()
+		return
+	}
+	sub.seen = updated
+	sessions := make([]string, 0, len(sub.sessions))
+	for id := range sub.sessions {
+		sessions = append(sessions, id)
+	}
+	w.mu.Unlock()
+
+	for _, id := range sessions {
+		err := w.server.SendNotificationToSpecificClient(id, mcp.MethodNotificationResourceUpdated, map[string]any{"uri": uri})
+		if errors.Is(err, server.ErrSessionNotFound) {
+			w.dropSession(id)
+		} else if err != nil {
+			logging.FromContext(ctx).Warn("resource update notification failed", "uri", uri, "error", err)
+		}
+	}
+	logging.FromContext(ctx).Debug("resource updated", "uri", uri, "sessions", len(sessions))
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+)
+
+func TestWatcherStopsOnShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := newWatcher(ctx, &config.APIConfig{PollInterval: time.Millisecond, PollBatchSize: 10})
+
+	done := make(chan struct{})
+	go func() {
+		w.run()
+		close(done)
+	}()
+	time.Sleep(5 * time.Millisecond)
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("watcher still polling after its context was cancelled")
+	}
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxTimelineResults caps the events of one timeline page.
+const maxTimelineResults = 1000
+
+func issueTimelineTool(cfg *config.APIConfig) models.Tool {
+	tool := mcp.NewTool("jira_issue_timeline",
+		mcp.WithDescription("Tells the story of an issue as one chronological list: its creation, every field change from the changelog, comments, worklogs and added or removed links, each with its time, author and a readable line such as \"Status: In Progress → Resolved by alice\". Filter by field, author and date range, and page through long histories."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("key or id of the issue, e.g. ABC-123")),
+		mcp.WithArray("fields", mcp.Description("only events of these fields, by the name the changelog uses, e.g. [\"Status\", \"assignee\", \"Fix Version\"]; Comment, Worklog, Link and Created select those events"), mcp.WithStringItems()),
+		mcp.WithArray("authors", mcp.Description("only events by these users, by username or display name"), mcp.WithStringItems()),
+		mcp.WithString("since", mcp.Description("only events at or after this date or time, e.g. 2017-11-01 or 2017-11-01T09:00:00+01:00")),
+		mcp.WithString("until", mcp.Description("only events before the end of this date, or before this time")),
+		mcp.WithString("order", mcp.Description("asc (default, oldest first) or desc"), mcp.Enum("asc", "desc")),
+		mcp.WithNumber("startAt", mcp.Description("index of the first event to return (default 0)")),
+		mcp.WithNumber("maxResults", mcp.Description("events to return (default 50, at most 1000)")),
+	)
+	return models.Tool{Definition: tool, Handler: issueTimelineHandler(cfg)}
+}
+
+// timelineEvent is one entry of an issue timeline. Field names the changed
+// field, or Created, Comment, Worklog or Link for other events.
+type timelineEvent struct {
+	Time      string `json:"time"`
+	Field     string `json:"field"`
+	Author    string `json:"author,omitempty"`
+	Summary   string `json:"summary"`
+	From      string `json:"from,omitempty"`
+	To        string `json:"to,omitempty"`
+	Body      string `json:"body,omitempty"`      // Comment text
+	Comment   string `json:"comment,omitempty"`   // Worklog comment
+	TimeSpent string `json:"timeSpent,omitempty"` // Worklog duration
+	ID        string `json:"id,omitempty"`        // Comment or worklog id
+
+	at       time.Time
+	authorID string // Username, for the authors filter
+}
+
+// timelinePage is the result of jira_issue_timeline.
+type timelinePage struct {
+	Issue      string          `json:"issue"`
+	Total      int             `json:"total"`
+	StartAt    int             `json:"startAt"`
+	MaxResults int             `json:"maxResults"`
+	Events     []timelineEvent `json:"events"`
+}
+
+// jiraUser is the part of a user Jira embeds in changelogs, comments and
+// worklogs.
+type jiraUser struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+func (u *jiraUser) String() string {
+	if u == nil {
+		return ""
+	}
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	return u.Name
+}
+
+func issueTimelineHandler(cfg *config.APIConfig) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		key, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		since, err := timelineBound(request.GetString("since", ""), false)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		until, err := timelineBound(request.GetString("until", ""), true)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		events, err := issueEvents(ctx, cfg, key)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to get the issue history", err), nil
+		}
+		fields := request.GetStringSlice("fields", nil)
+		authors := request.GetStringSlice("authors", nil)
+		events = slices.DeleteFunc(events, func(e timelineEvent) bool {
+			switch {
+			case len(fields) > 0 && !containsFold(fields, e.Field):
+				return true
+			case len(authors) > 0 && !containsFold(authors, e.authorID) && !containsFold(authors, e.Author):
+				return true
+			case !since.IsZero() && e.at.Before(since):
+				return true
+			case !until.IsZero() && !e.at.Before(until):
+				return true
+			}
+			return false
+		})
+		slices.SortStableFunc(events, func(a, b timelineEvent) int { return a.at.Compare(b.at) })
+		if request.GetString("order", "asc") == "desc" {
+			slices.Reverse(events)
+		}
+
+		startAt := max(request.GetInt("startAt", 0), 0)
+		maxResults := min(max(request.GetInt("maxResults", 50), 1), maxTimelineResults)
+		page := timelinePage{Issue: key, Total: len(events), StartAt: startAt, MaxResults: maxResults, Events: []timelineEvent{}}
+		if startAt < len(events) {
+			page.Events = events[startAt:min(startAt+maxResults, len(events))]
+		}
+		logging.FromContext(ctx).Info("issue timeline built", "issue", key, "events", len(events))
+
+		pretty, err := json.MarshalIndent(page, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+		}
+		return mcp.NewToolResultText(string(pretty)), nil
+	}
+}
+
+// issueEvents collects the events of an issue from its changelog, comments
+// and worklogs. Link events come from the changelog, since issue links
+// carry no time of their own.
+func issueEvents(ctx context.Context, cfg *config.APIConfig, key string) ([]timelineEvent, error) {
+	var issue struct {
+		Fields struct {
+			Created  string    `json:"created"`
+			Reporter *jiraUser `json:"reporter"`
+			Creator  *jiraUser `json:"creator"`
+		} `json:"fields"`
+		Changelog struct {
+			Histories []struct {
+				Author  *jiraUser `json:"author"`
+				Created string    `json:"created"`
+				Items   []struct {
+					Field      string `json:"field"`
+					FromString string `json:"fromString"`
+					ToString   string `json:"toString"`
+				} `json:"items"`
+			} `json:"histories"`
+		} `json:"changelog"`
+	}
+	base := "/api/2/issue/" + url.PathEscape(key)
+	if err := getJSON(ctx, cfg, base+"?fields=created,reporter,creator&expand=changelog", &issue); err != nil {
+		return nil, err
+	}
+	var comments struct {
+		Comments []struct {
+			ID           string    `json:"id"`
+			Author       *jiraUser `json:"author"`
+			UpdateAuthor *jiraUser `json:"updateAuthor"`
+			Body         string    `json:"body"`
+			Created      string    `json:"created"`
+			Updated      string    `json:"updated"`
+		} `json:"comments"`
+	}
+	if err := getJSON(ctx, cfg, base+"/comment", &comments); err != nil {
+		return nil, err
+	}
+	var worklogs struct {
+		Worklogs []struct {
+			ID        string    `json:"id"`
+			Author    *jiraUser `json:"author"`
+			Comment   string    `json:"comment"`
+			Started   string    `json:"started"`
+			TimeSpent string    `json:"timeSpent"`
+		} `json:"worklogs"`
+	}
+	if err := getJSON(ctx, cfg, base+"/worklog", &worklogs); err != nil {
+		return nil, err
+	}
+
+	var events []timelineEvent
+	add := func(e timelineEvent, when string, author *jiraUser) {
+		e.Time, e.at = when, parseJiraTime(when)
+		e.Author = author.String()
+		if author != nil {
+			e.authorID = author.Name
+		}
+		if e.Author != "" {
+			e.Summary += " by " + e.Author
+		}
+		events = append(events, e)
+	}
+
+	creator := issue.Fields.Creator
+	if creator == nil {
+		creator = issue.Fields.Reporter
+	}
+	add(timelineEvent{Field: "Created", Summary: "Created"}, issue.Fields.Created, creator)
+	for _, h := range issue.Changelog.Histories {
+		for _, item := range h.Items {
+			e := timelineEvent{Field: item.Field, From: item.FromString, To: item.ToString}
+			switch {
+			case strings.EqualFold(item.Field, "Link") && item.ToString != "":
+				e.Field, e.Summary = "Link", fmt.Sprintf("Link added: %s", item.ToString)
+			case strings.EqualFold(item.Field, "Link"):
+				e.Field, e.Summary = "Link", fmt.Sprintf("Link removed: %s", item.FromString)
+			default:
+				e.Summary = fmt.Sprintf("%s: %s → %s", fieldLabel(item.Field), orNone(item.FromString), orNone(item.ToString))
+			}
+			add(e, h.Created, h.Author)
+		}
+	}
+	for _, c := range comments.Comments {
+		add(timelineEvent{Field: "Comment", Summary: "Comment", Body: c.Body, ID: c.ID}, c.Created, c.Author)
+		if c.Updated != "" && c.Updated != c.Created {
+			edited := ", edited " + c.Updated
+			if editor := c.UpdateAuthor.String(); editor != "" {
+				edited += " by " + editor
+			}
+			events[len(events)-1].Summary += edited
+		}
+	}
+	for _, w := range worklogs.Worklogs {
+		add(timelineEvent{Field: "Worklog", Summary: "Logged " + w.TimeSpent, Comment: w.Comment, TimeSpent: w.TimeSpent, ID: w.ID}, w.Started, w.Author)
+	}
+	return events, nil
+}
+
+// fieldLabel capitalizes the lower-case names the changelog uses for some
+// system fields, e.g. status and assignee.
+func fieldLabel(field string) string {
+	if field == "" {
+		return field
+	}
+	return strings.ToUpper(field[:1]) + field[1:]
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
+
+// parseJiraTime parses the timestamps in Jira responses, returning the zero
+// time for anything else.
+func parseJiraTime(s string) time.Time {
+	t, _ := time.Parse(jiraDateTime, s)
+	return t
+}
+
+// timelineBound parses a since or until argument in one of dateLayouts. A
+// date without a time ends a range at the end of that day.
+func timelineBound(value string, end bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range dateLayouts {
+		t, err := time.Parse(layout, value)
+		if err != nil {
+			continue
+		}
+		if end && layout == "2006-01-02" {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("%q is not a date or time, use YYYY-MM-DD or YYYY-MM-DDThh:mm:ss", value)
+}
+
+func containsFold(list []string, s string) bool {
+	return s != "" && slices.ContainsFunc(list, func(item string) bool { return strings.EqualFold(item, s) })
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// timelineJira serves the history of ABC-1.
+func timelineJira(t *testing.T) *config.APIConfig {
+	t.Helper()
+	responses := map[string]string{
+		"/rest/api/2/issue/ABC-1": `{
+			"fields": {"created": "2017-11-01T09:00:00.000+0000", "creator": {"name": "alice", "displayName": "Alice"}},
+			"changelog": {"histories": [
+				{"author": {"name": "bob", "displayName": "Bob"}, "created": "2017-11-02T10:00:00.000+0000", "items": [
+					{"field": "status", "fromString": "Open", "toString": "In Progress"},
+					{"field": "assignee", "fromString": "", "toString": "Bob"}
+				]},
+				{"author": {"name": "alice", "displayName": "Alice"}, "created": "2017-11-04T10:00:00.000+0000", "items": [
+					{"field": "Link", "toString": "This issue blocks ABC-2"}
+				]}
+			]}
+		}`,
+		"/rest/api/2/issue/ABC-1/comment": `{"comments": [
+			{"id": "100", "author": {"name": "carol", "displayName": "Carol"}, "updateAuthor": {"name": "bob", "displayName": "Bob"},
+			 "body": "Looks like a cache bug", "created": "2017-11-03T08:00:00.000+0000", "updated": "2017-11-03T09:00:00.000+0000"},
+			{"id": "101", "author": {"name": "carol", "displayName": "Carol"},
+			 "body": "Fixed", "created": "2017-11-05T08:00:00.000+0000", "updated": "2017-11-05T09:00:00.000+0000"}
+		]}`,
+		"/rest/api/2/issue/ABC-1/worklog": `{"worklogs": [
+			{"id": "200", "author": {"name": "bob", "displayName": "Bob"}, "comment": "debugging", "started": "2017-11-02T12:00:00.000+0000", "timeSpent": "1h"}
+		]}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return &config.APIConfig{BaseURL: srv.URL + "/rest"}
+}
+
+func TestIssueEvents(t *testing.T) {
+	events, err := issueEvents(context.Background(), timelineJira(t), "ABC-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, e := range events {
+		got = append(got, e.Field+": "+e.Summary)
+	}
+	want := []string{
+		"Created: Created by Alice",
+		"status: Status: Open → In Progress by Bob",
+		"assignee: Assignee: (none) → Bob by Bob",
+		"Link: Link added: This issue blocks ABC-2 by Alice",
+		"Comment: Comment by Carol, edited 2017-11-03T09:00:00.000+0000 by Bob",
+		"Comment: Comment by Carol, edited 2017-11-05T09:00:00.000+0000",
+		"Worklog: Logged 1h by Bob",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("issueEvents = %q, want %q", got, want)
+	}
+}
+
+func TestIssueTimelineHandler(t *testing.T) {
+	handler := issueTimelineHandler(timelineJira(t))
+	tests := []struct {
+		name string
+		args map[string]any
+		want []string // Summaries of the page
+	}{
+		{
+			name: "chronological",
+			args: map[string]any{"maxResults": 3},
+			want: []string{"Created by Alice", "Status: Open → In Progress by Bob", "Assignee: (none) → Bob by Bob"},
+		},
+		{
+			name: "fields and authors",
+			args: map[string]any{"fields": []any{"Status", "Worklog", "Comment"}, "authors": []any{"bob"}},
+			want: []string{"Status: Open → In Progress by Bob", "Logged 1h by Bob"},
+		},
+		{
+			name: "date range, newest first",
+			args: map[string]any{"since": "2017-11-03", "until": "2017-11-03", "order": "desc"},
+			want: []string{"Comment by Carol, edited 2017-11-03T09:00:00.000+0000 by Bob"},
+		},
+		{
+			name: "second page",
+			args: map[string]any{"startAt": 4, "maxResults": 2},
+			want: []string{"Comment by Carol, edited 2017-11-03T09:00:00.000+0000 by Bob", "Link added: This issue blocks ABC-2 by Alice"},
+		},
+		{
+			name: "maxResults below 1",
+			args: map[string]any{"maxResults": -5},
+			want: []string{"Created by Alice"},
+		},
+	}
+	for _, tt := range tests {
+		var request mcp.CallToolRequest
+		request.Params.Arguments = tt.args
+		request.Params.Arguments.(map[string]any)["issueIdOrKey"] = "ABC-1"
+		result, err := handler(context.Background(), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if result.IsError {
+			t.Errorf("%s: %s", tt.name, text)
+			continue
+		}
+		var page timelinePage
+		if err := json.Unmarshal([]byte(text), &page); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		var got []string
+		for _, e := range page.Events {
+			got = append(got, e.Summary)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: events = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
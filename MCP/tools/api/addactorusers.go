@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func AddactorusersHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/project/%s/role/%s", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		projectIdOrKey, err := request.RequireString("projectIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/%s/role/%s", cfg.BaseURL, url.PathEscape(projectIdOrKey), url.PathEscape(id))
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +65,8 @@ func AddactorusersHandler(cfg *config.APIConfig) func(ctx context.Context, reque
 func CreateAddactorusersTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_project_projectIdOrKey_role_id",
 		mcp.WithDescription("Adds an actor (user or group) to a project role."),
+		mcp.WithString("projectIdOrKey", mcp.Required(), mcp.Description("the project id or key")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the role id")),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func AddcommentHandler(cfg *config.APIConfig) func(ctx context.Context, request
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/issue/%s/comment%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("POST", url, nil)
+		issueIdOrKey, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issue/%s/comment%s", cfg.BaseURL, url.PathEscape(issueIdOrKey), queryString)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -68,6 +74,7 @@ func AddcommentHandler(cfg *config.APIConfig) func(ctx context.Context, request
 func CreateAddcommentTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_issue_issueIdOrKey_comment",
 		mcp.WithDescription("Adds a new comment to an issue."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("the issue id or key")),
 		mcp.WithString("expand", mcp.Description("optional flags: renderedBody (provides body rendered in HTML)")),
 	)
 
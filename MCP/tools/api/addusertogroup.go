@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,21 @@ func AddusertogroupHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["groupname"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("groupname=%v", val))
+			queryParams = append(queryParams, "groupname="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/group/user%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("POST", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,9 +69,7 @@ func AddusertogroupHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 
 func CreateAddusertogroupTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_group_user",
-		mcp.WithDescription("Adds given user to a group.
- <p>
- Returns the current state of the group."),
+		mcp.WithDescription("Adds given user to a group. Full documentation: describe_jira_operation."),
 		mcp.WithString("groupname", mcp.Description("A name of requested group.")),
 	)
 
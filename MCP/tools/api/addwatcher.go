@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func AddwatcherHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/issue/%s/watchers", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		issueIdOrKey, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issue/%s/watchers", cfg.BaseURL, url.PathEscape(issueIdOrKey))
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func AddwatcherHandler(cfg *config.APIConfig) func(ctx context.Context, request
 func CreateAddwatcherTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_issue_issueIdOrKey_watchers",
 		mcp.WithDescription("Adds a user to an issue's watcher list."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("the issue id or key")),
 	)
 
 	return models.Tool{
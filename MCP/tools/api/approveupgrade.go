@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,14 +16,14 @@ import (
 func ApproveupgradeHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		url := fmt.Sprintf("%s/api/2/cluster/zdu/approve", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,7 +55,7 @@ func ApproveupgradeHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 
 func CreateApproveupgradeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_cluster_zdu_approve",
-		mcp.WithDescription(""),
+		mcp.WithDescription("POST /api/2/cluster/zdu/approve"),
 	)
 
 	return models.Tool{
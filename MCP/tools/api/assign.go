@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func AssignHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/issue/%s/assignee", cfg.BaseURL)
-		req, err := http.NewRequest("PUT", url, nil)
+		issueIdOrKey, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issue/%s/assignee", cfg.BaseURL, url.PathEscape(issueIdOrKey))
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,10 +60,8 @@ func AssignHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.
 
 func CreateAssignTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_issue_issueIdOrKey_assignee",
-		mcp.WithDescription("Assigns an issue to a user.
- You can use this resource to assign issues when the user submitting the request has the assign permission but not the
- edit issue permission.
- If the name is "-1" automatic assignee is used.  A null name will remove the assignee."),
+		mcp.WithDescription("Assigns an issue to a user. You can use this resource to assign issues when the user submitting the request has the assign permission but not the edit issue permission. If the name is \"-1\" automatic assignee is used. A null name will remove the assignee."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("a String containing an issue key")),
 	)
 
 	return models.Tool{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func CanmovesubtaskHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/issue/%s/subtask/move", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		issueIdOrKey, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issue/%s/subtask/move", cfg.BaseURL, url.PathEscape(issueIdOrKey))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,7 +60,8 @@ func CanmovesubtaskHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 
 func CreateCanmovesubtaskTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_issue_issueIdOrKey_subtask_move",
-		mcp.WithDescription(""),
+		mcp.WithDescription("GET /api/2/issue/{issueIdOrKey}/subtask/move"),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("the issue id or key")),
 	)
 
 	return models.Tool{
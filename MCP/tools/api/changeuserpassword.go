@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,24 @@ func ChangeuserpasswordHandler(cfg *config.APIConfig) func(ctx context.Context,
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["username"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("username=%v", val))
+			queryParams = append(queryParams, "username="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["key"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("key=%v", val))
+			queryParams = append(queryParams, "key="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/user/password%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("PUT", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func CreatedraftforparentHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/createdraft", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/createdraft", cfg.BaseURL, url.PathEscape(id))
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func CreatedraftforparentHandler(cfg *config.APIConfig) func(ctx context.Context
 func CreateCreatedraftforparentTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_workflowscheme_id_createdraft",
 		mcp.WithDescription("Create a draft for the passed scheme. The draft will be a copy of the state of the parent."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the id of the parent scheme.")),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,21 @@ func CreatefilterHandler(cfg *config.APIConfig) func(ctx context.Context, reques
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/filter%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("POST", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,8 +69,7 @@ func CreatefilterHandler(cfg *config.APIConfig) func(ctx context.Context, reques
 
 func CreateCreatefilterTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_filter",
-		mcp.WithDescription("Creates a new filter, and returns newly created filter.
- Currently sets permissions just using the users default sharing permissions"),
+		mcp.WithDescription("Creates a new filter, and returns newly created filter. Currently sets permissions just using the users default sharing permissions"),
 		mcp.WithString("expand", mcp.Description("the parameters to expand")),
 	)
 
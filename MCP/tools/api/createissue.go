@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,14 +16,14 @@ import (
 func CreateissueHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		url := fmt.Sprintf("%s/api/2/issue", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,20 +55,7 @@ func CreateissueHandler(cfg *config.APIConfig) func(ctx context.Context, request
 
 func CreateCreateissueTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_issue",
-		mcp.WithDescription("Creates an issue or a sub-task from a JSON representation.
- <p/>
- The fields that can be set on create, in either the fields parameter or the update parameter can be determined
- using the <b>/rest/api/2/issue/createmeta</b> resource.
- If a field is not configured to appear on the create screen, then it will not be in the createmeta, and a field
- validation error will occur if it is submitted.
- <p/>
- Creating a sub-task is similar to creating a regular issue, with two important differences:
- <ul>
- <li>the <code>issueType</code> field must correspond to a sub-task issue type (you can use
- <code>/issue/createmeta</code> to discover sub-task issue types), and</li>
- <li>you must provide a <code>parent</code> field in the issue create request containing the id or key of the
- parent issue.</li>
- </ul>"),
+		mcp.WithDescription("Creates an issue or a sub-task from a JSON representation. Full documentation: describe_jira_operation."),
 	)
 
 	return models.Tool{
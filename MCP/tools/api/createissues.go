@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,14 +16,14 @@ import (
 func CreateissuesHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		url := fmt.Sprintf("%s/api/2/issue/bulk", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,12 +55,7 @@ func CreateissuesHandler(cfg *config.APIConfig) func(ctx context.Context, reques
 
 func CreateCreateissuesTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_issue_bulk",
-		mcp.WithDescription("Creates issues or sub-tasks from a JSON representation.
- <p/>
- Creates many issues in one bulk operation.
- <p/>
- Creating a sub-task is similar to creating a regular issue. More details can be found in createIssue section:
- {@link IssueResource#createIssue(IssueUpdateBean)}}"),
+		mcp.WithDescription("Creates issues or sub-tasks from a JSON representation. Full documentation: describe_jira_operation."),
 	)
 
 	return models.Tool{
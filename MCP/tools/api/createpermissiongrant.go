@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func CreatepermissiongrantHandler(cfg *config.APIConfig) func(ctx context.Contex
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/permissionscheme/%s/permission%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("POST", url, nil)
+		schemeId, err := request.RequireString("schemeId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/permissionscheme/%s/permission%s", cfg.BaseURL, url.PathEscape(schemeId), queryString)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -68,6 +74,7 @@ func CreatepermissiongrantHandler(cfg *config.APIConfig) func(ctx context.Contex
 func CreateCreatepermissiongrantTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_permissionscheme_schemeId_permission",
 		mcp.WithDescription("Creates a permission grant in a permission scheme."),
+		mcp.WithString("schemeId", mcp.Required(), mcp.Description("the scheme id")),
 		mcp.WithString("expand", mcp.Description("")),
 	)
 
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,27 +23,31 @@ func CreatepropertyHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["key"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("key=%v", val))
+			queryParams = append(queryParams, "key="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["workflowName"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("workflowName=%v", val))
+			queryParams = append(queryParams, "workflowName="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["workflowMode"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("workflowMode=%v", val))
+			queryParams = append(queryParams, "workflowMode="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/workflow/api/2/transitions/%s/properties%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("POST", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/workflow/api/2/transitions/%s/properties%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -73,8 +79,8 @@ func CreatepropertyHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 
 func CreateCreatepropertyTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_workflow_api_2_transitions_id_properties",
-		mcp.WithDescription("Add a new property to a transition. Trying to add a property that already
- exists will fail."),
+		mcp.WithDescription("Add a new property to a transition. Trying to add a property that already exists will fail."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the transitions id")),
 		mcp.WithString("key", mcp.Description("the name of the property to add.")),
 		mcp.WithString("workflowName", mcp.Description("the name of the workflow to use.")),
 		mcp.WithString("workflowMode", mcp.Description("the type of workflow to use. Can either be \"live\" or \"draft\".")),
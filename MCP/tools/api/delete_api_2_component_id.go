@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func Delete_api_2_component_idHandler(cfg *config.APIConfig) func(ctx context.Co
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["moveIssuesTo"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("moveIssuesTo=%v", val))
+			queryParams = append(queryParams, "moveIssuesTo="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/component/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/component/%s%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -68,7 +74,8 @@ func Delete_api_2_component_idHandler(cfg *config.APIConfig) func(ctx context.Co
 func CreateDelete_api_2_component_idTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_component_id",
 		mcp.WithDescription("Delete a project component."),
-		mcp.WithString("moveIssuesTo", mcp.Description("The new component applied to issues whose 'id' component will be deleted.\n                     If this value is null, then the 'id' component is simply removed from the related isues.")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the component id")),
+		mcp.WithString("moveIssuesTo", mcp.Description("The new component applied to issues whose 'id' component will be deleted. If this value is null, then the 'id' component is simply removed from the related isues.")),
 	)
 
 	return models.Tool{
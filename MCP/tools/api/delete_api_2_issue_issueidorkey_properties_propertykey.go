@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func Delete_api_2_issue_issueidorkey_properties_propertykeyHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/issue/%s/properties/%s", cfg.BaseURL)
-		req, err := http.NewRequest("DELETE", url, nil)
+		issueIdOrKey, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		propertyKey, err := request.RequireString("propertyKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issue/%s/properties/%s", cfg.BaseURL, url.PathEscape(issueIdOrKey), url.PathEscape(propertyKey))
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,8 +64,9 @@ func Delete_api_2_issue_issueidorkey_properties_propertykeyHandler(cfg *config.A
 
 func CreateDelete_api_2_issue_issueidorkey_properties_propertykeyTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_issue_issueIdOrKey_properties_propertyKey",
-		mcp.WithDescription("Removes the property from the issue identified by the key or by the id. Ths user removing the property is required
- to have permissions to edit the issue."),
+		mcp.WithDescription("Removes the property from the issue identified by the key or by the id. Ths user removing the property is required to have permissions to edit the issue."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("the issue id or key")),
+		mcp.WithString("propertyKey", mcp.Required(), mcp.Description("the property key")),
 	)
 
 	return models.Tool{
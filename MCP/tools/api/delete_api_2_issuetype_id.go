@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func Delete_api_2_issuetype_idHandler(cfg *config.APIConfig) func(ctx context.Co
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["alternativeIssueTypeId"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("alternativeIssueTypeId=%v", val))
+			queryParams = append(queryParams, "alternativeIssueTypeId="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/issuetype/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issuetype/%s%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,9 +73,8 @@ func Delete_api_2_issuetype_idHandler(cfg *config.APIConfig) func(ctx context.Co
 
 func CreateDelete_api_2_issuetype_idTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_issuetype_id",
-		mcp.WithDescription("Deletes the specified issue type. If the issue type has any associated issues, these issues will be migrated to
- the alternative issue type specified in the parameter. You can determine the alternative issue types by calling
- the <b>/rest/api/2/issuetype/{id}/alternatives</b> resource."),
+		mcp.WithDescription("Deletes the specified issue type. If the issue type has any associated issues, these issues will be migrated to the alternative issue type specified in the parameter. You can determine the alternative issue types by calling the **/rest/api/2/issuetype/{id}/alternatives** resource."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the issuetype id")),
 		mcp.WithString("alternativeIssueTypeId", mcp.Description("the id of an issue type to which issues associated with the removed issue type will be migrated.")),
 	)
 
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func Delete_api_2_project_projectidorkey_properties_propertykeyHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/project/%s/properties/%s", cfg.BaseURL)
-		req, err := http.NewRequest("DELETE", url, nil)
+		projectIdOrKey, err := request.RequireString("projectIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		propertyKey, err := request.RequireString("propertyKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/%s/properties/%s", cfg.BaseURL, url.PathEscape(projectIdOrKey), url.PathEscape(propertyKey))
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,8 +64,9 @@ func Delete_api_2_project_projectidorkey_properties_propertykeyHandler(cfg *conf
 
 func CreateDelete_api_2_project_projectidorkey_properties_propertykeyTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_project_projectIdOrKey_properties_propertyKey",
-		mcp.WithDescription("Removes the property from the project identified by the key or by the id. Ths user removing the property is required
- to have permissions to administer the project."),
+		mcp.WithDescription("Removes the property from the project identified by the key or by the id. Ths user removing the property is required to have permissions to administer the project."),
+		mcp.WithString("projectIdOrKey", mcp.Required(), mcp.Description("the project id or key")),
+		mcp.WithString("propertyKey", mcp.Required(), mcp.Description("the property key")),
 	)
 
 	return models.Tool{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,27 @@ import (
 
 func Delete_api_2_universal_avatar_type_type_owner_owningobjectid_avatar_idHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/universal_avatar/type/%s/owner/%s/avatar/%s", cfg.BaseURL)
-		req, err := http.NewRequest("DELETE", url, nil)
+		typeParam, err := request.RequireString("type")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		owningObjectId, err := request.RequireString("owningObjectId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/universal_avatar/type/%s/owner/%s/avatar/%s", cfg.BaseURL, url.PathEscape(typeParam), url.PathEscape(owningObjectId), url.PathEscape(id))
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +69,9 @@ func Delete_api_2_universal_avatar_type_type_owner_owningobjectid_avatar_idHandl
 func CreateDelete_api_2_universal_avatar_type_type_owner_owningobjectid_avatar_idTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_universal_avatar_type_type_owner_owningObjectId_avatar_id",
 		mcp.WithDescription("Deletes avatar"),
+		mcp.WithString("type", mcp.Required(), mcp.Description("Project id or project key")),
+		mcp.WithString("owningObjectId", mcp.Required(), mcp.Description("")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("database id for avatar")),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func Delete_api_2_user_avatar_idHandler(cfg *config.APIConfig) func(ctx context.
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["username"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("username=%v", val))
+			queryParams = append(queryParams, "username="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/user/avatar/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/user/avatar/%s%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -68,6 +74,7 @@ func Delete_api_2_user_avatar_idHandler(cfg *config.APIConfig) func(ctx context.
 func CreateDelete_api_2_user_avatar_idTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_user_avatar_id",
 		mcp.WithDescription("Deletes avatar"),
+		mcp.WithString("id", mcp.Required(), mcp.Description("database id for avatar")),
 		mcp.WithString("username", mcp.Description("username")),
 	)
 
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,28 @@ func Delete_api_2_user_properties_propertykeyHandler(cfg *config.APIConfig) func
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["userKey"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("userKey=%v", val))
+			queryParams = append(queryParams, "userKey="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["username"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("username=%v", val))
+			queryParams = append(queryParams, "username="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/user/properties/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		propertyKey, err := request.RequireString("propertyKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/user/properties/%s%s", cfg.BaseURL, url.PathEscape(propertyKey), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -70,8 +76,8 @@ func Delete_api_2_user_properties_propertykeyHandler(cfg *config.APIConfig) func
 
 func CreateDelete_api_2_user_properties_propertykeyTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_user_properties_propertyKey",
-		mcp.WithDescription("Removes the property from the user identified by the key or by the id. Ths user removing the property is required
- to have permissions to administer the user."),
+		mcp.WithDescription("Removes the property from the user identified by the key or by the id. Ths user removing the property is required to have permissions to administer the user."),
+		mcp.WithString("propertyKey", mcp.Required(), mcp.Description("the property key")),
 		mcp.WithString("userKey", mcp.Description("key of the user whose property is to be removed")),
 		mcp.WithString("username", mcp.Description("username of the user whose property is to be removed")),
 	)
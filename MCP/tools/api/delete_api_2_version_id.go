@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,28 @@ func Delete_api_2_version_idHandler(cfg *config.APIConfig) func(ctx context.Cont
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["moveFixIssuesTo"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("moveFixIssuesTo=%v", val))
+			queryParams = append(queryParams, "moveFixIssuesTo="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["moveAffectedIssuesTo"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("moveAffectedIssuesTo=%v", val))
+			queryParams = append(queryParams, "moveAffectedIssuesTo="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/version/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/version/%s%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -71,8 +77,9 @@ func Delete_api_2_version_idHandler(cfg *config.APIConfig) func(ctx context.Cont
 func CreateDelete_api_2_version_idTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_version_id",
 		mcp.WithDescription("Delete a project version."),
-		mcp.WithString("moveFixIssuesTo", mcp.Description("The version to set fixVersion to on issues where the deleted version is the fix version,\n                             If null then the fixVersion is removed.")),
-		mcp.WithString("moveAffectedIssuesTo", mcp.Description("The version to set affectedVersion to on issues where the deleted version is the affected version,\n                             If null then the affectedVersion is removed.")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the version id")),
+		mcp.WithString("moveFixIssuesTo", mcp.Description("The version to set fixVersion to on issues where the deleted version is the fix version, If null then the fixVersion is removed.")),
+		mcp.WithString("moveAffectedIssuesTo", mcp.Description("The version to set affectedVersion to on issues where the deleted version is the affected version, If null then the affectedVersion is removed.")),
 	)
 
 	return models.Tool{
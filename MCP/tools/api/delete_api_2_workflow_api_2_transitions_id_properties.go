@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,27 +23,31 @@ func Delete_api_2_workflow_api_2_transitions_id_propertiesHandler(cfg *config.AP
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["key"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("key=%v", val))
+			queryParams = append(queryParams, "key="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["workflowName"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("workflowName=%v", val))
+			queryParams = append(queryParams, "workflowName="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["workflowMode"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("workflowMode=%v", val))
+			queryParams = append(queryParams, "workflowMode="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/workflow/api/2/transitions/%s/properties%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/workflow/api/2/transitions/%s/properties%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -73,8 +79,8 @@ func Delete_api_2_workflow_api_2_transitions_id_propertiesHandler(cfg *config.AP
 
 func CreateDelete_api_2_workflow_api_2_transitions_id_propertiesTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_workflow_api_2_transitions_id_properties",
-		mcp.WithDescription("Delete a property from the passed transition on the passed workflow. It is not an error to delete a property that
- does not exist."),
+		mcp.WithDescription("Delete a property from the passed transition on the passed workflow. It is not an error to delete a property that does not exist."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the transitions id")),
 		mcp.WithString("key", mcp.Description("the name of the property to add.")),
 		mcp.WithString("workflowName", mcp.Description("the name of the workflow to use.")),
 		mcp.WithString("workflowMode", mcp.Description("the type of workflow to use. Can either be \"live\" or \"draft\".")),
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,29 @@ func Delete_api_2_workflowscheme_id_issuetype_issuetypeHandler(cfg *config.APICo
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["updateDraftIfNeeded"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("updateDraftIfNeeded=%v", val))
+			queryParams = append(queryParams, "updateDraftIfNeeded="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/issuetype/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		issueType, err := request.RequireString("issueType")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/issuetype/%s%s", cfg.BaseURL, url.PathEscape(id), url.PathEscape(issueType), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -68,7 +78,9 @@ func Delete_api_2_workflowscheme_id_issuetype_issuetypeHandler(cfg *config.APICo
 func CreateDelete_api_2_workflowscheme_id_issuetype_issuetypeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_workflowscheme_id_issuetype_issueType",
 		mcp.WithDescription("Remove the specified issue type mapping from the scheme."),
-		mcp.WithString("updateDraftIfNeeded", mcp.Description("when true will create and return a draft when the workflow scheme cannot be edited\n                            (e.g. when it is being used by a project).")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the workflowscheme id")),
+		mcp.WithString("issueType", mcp.Required(), mcp.Description("the issue type")),
+		mcp.WithString("updateDraftIfNeeded", mcp.Description("when true will create and return a draft when the workflow scheme cannot be edited (e.g. when it is being used by a project).")),
 	)
 
 	return models.Tool{
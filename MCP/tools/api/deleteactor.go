@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,32 @@ func DeleteactorHandler(cfg *config.APIConfig) func(ctx context.Context, request
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["user"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("user=%v", val))
+			queryParams = append(queryParams, "user="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["group"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("group=%v", val))
+			queryParams = append(queryParams, "group="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/project/%s/role/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		projectIdOrKey, err := request.RequireString("projectIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/%s/role/%s%s", cfg.BaseURL, url.PathEscape(projectIdOrKey), url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -70,12 +80,9 @@ func DeleteactorHandler(cfg *config.APIConfig) func(ctx context.Context, request
 
 func CreateDeleteactorTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_project_projectIdOrKey_role_id",
-		mcp.WithDescription("Deletes actors (users or groups) from a project role.
- <p>
- <ul>
- <li>Delete a user from the role: <code>/rest/api/2/project/{projectIdOrKey}/role/{roleId}?user={username}</code></li>
- <li>Delete a group from the role: <code>/rest/api/2/project/{projectIdOrKey}/role/{roleId}?group={groupname}</code></li>
- </ul>"),
+		mcp.WithDescription("Deletes actors (users or groups) from a project role. Full documentation: describe_jira_operation."),
+		mcp.WithString("projectIdOrKey", mcp.Required(), mcp.Description("the project id or key")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the role id")),
 		mcp.WithString("user", mcp.Description("the username to remove from the project role")),
 		mcp.WithString("group", mcp.Description("the groupname to remove from the project role")),
 	)
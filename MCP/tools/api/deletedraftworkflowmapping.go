@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func DeletedraftworkflowmappingHandler(cfg *config.APIConfig) func(ctx context.C
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["workflowName"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("workflowName=%v", val))
+			queryParams = append(queryParams, "workflowName="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/draft/workflow%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/draft/workflow%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -68,6 +74,7 @@ func DeletedraftworkflowmappingHandler(cfg *config.APIConfig) func(ctx context.C
 func CreateDeletedraftworkflowmappingTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_workflowscheme_id_draft_workflow",
 		mcp.WithDescription("Delete the passed workflow from the draft workflow scheme."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the workflowscheme id")),
 		mcp.WithString("workflowName", mcp.Description("the name of the workflow to delete.")),
 	)
 
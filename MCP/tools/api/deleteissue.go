@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func DeleteissueHandler(cfg *config.APIConfig) func(ctx context.Context, request
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["deleteSubtasks"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("deleteSubtasks=%v", val))
+			queryParams = append(queryParams, "deleteSubtasks="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/issue/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		issueIdOrKey, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issue/%s%s", cfg.BaseURL, url.PathEscape(issueIdOrKey), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,11 +73,9 @@ func DeleteissueHandler(cfg *config.APIConfig) func(ctx context.Context, request
 
 func CreateDeleteissueTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_issue_issueIdOrKey",
-		mcp.WithDescription("Delete an issue.
- <p/>
- If the issue has subtasks you must set the parameter deleteSubtasks=true to delete the issue.
- You cannot delete an issue without its subtasks also being deleted."),
-		mcp.WithString("deleteSubtasks", mcp.Description("a String of true or false indicating that any subtasks should also be deleted.  If the\n                       issue has no subtasks this parameter is ignored.  If the issue has subtasks and this parameter is missing or false,\n                       then the issue will not be deleted and an error will be returned.")),
+		mcp.WithDescription("Delete an issue. Full documentation: describe_jira_operation."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("the issue id or key")),
+		mcp.WithString("deleteSubtasks", mcp.Description("a String of true or false indicating that any subtasks should also be deleted. If the issue has no subtasks this parameter is ignored. If the issue has subtasks and this parameter is missing or false, then the issue will not be deleted and an error will be returned.")),
 	)
 
 	return models.Tool{
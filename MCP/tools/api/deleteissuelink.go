@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func DeleteissuelinkHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/issueLink/%s", cfg.BaseURL)
-		req, err := http.NewRequest("DELETE", url, nil)
+		linkId, err := request.RequireString("linkId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issueLink/%s", cfg.BaseURL, url.PathEscape(linkId))
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,9 +60,8 @@ func DeleteissuelinkHandler(cfg *config.APIConfig) func(ctx context.Context, req
 
 func CreateDeleteissuelinkTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_issueLink_linkId",
-		mcp.WithDescription("Deletes an issue link with the specified id.
- To be able to delete an issue link you must be able to view both issues and must have the link issue permission
- for at least one of the issues."),
+		mcp.WithDescription("Deletes an issue link with the specified id. To be able to delete an issue link you must be able to view both issues and must have the link issue permission for at least one of the issues."),
+		mcp.WithString("linkId", mcp.Required(), mcp.Description("the link id")),
 	)
 
 	return models.Tool{
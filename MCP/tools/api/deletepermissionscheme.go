@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func DeletepermissionschemeHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/permissionscheme/%s", cfg.BaseURL)
-		req, err := http.NewRequest("DELETE", url, nil)
+		schemeId, err := request.RequireString("schemeId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/permissionscheme/%s", cfg.BaseURL, url.PathEscape(schemeId))
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func DeletepermissionschemeHandler(cfg *config.APIConfig) func(ctx context.Conte
 func CreateDeletepermissionschemeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_permissionscheme_schemeId",
 		mcp.WithDescription("Deletes a permission scheme identified by the given id."),
+		mcp.WithString("schemeId", mcp.Required(), mcp.Description("the scheme id")),
 	)
 
 	return models.Tool{
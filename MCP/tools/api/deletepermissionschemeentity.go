@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func DeletepermissionschemeentityHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/permissionscheme/%s/permission/%s", cfg.BaseURL)
-		req, err := http.NewRequest("DELETE", url, nil)
+		schemeId, err := request.RequireString("schemeId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		permissionId, err := request.RequireString("permissionId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/permissionscheme/%s/permission/%s", cfg.BaseURL, url.PathEscape(schemeId), url.PathEscape(permissionId))
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +65,8 @@ func DeletepermissionschemeentityHandler(cfg *config.APIConfig) func(ctx context
 func CreateDeletepermissionschemeentityTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_permissionscheme_schemeId_permission_permissionId",
 		mcp.WithDescription("Deletes a permission grant from a permission scheme."),
+		mcp.WithString("schemeId", mcp.Required(), mcp.Description("the scheme id")),
+		mcp.WithString("permissionId", mcp.Required(), mcp.Description("the permission id")),
 	)
 
 	return models.Tool{
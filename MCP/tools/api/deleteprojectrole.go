@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func DeleteprojectroleHandler(cfg *config.APIConfig) func(ctx context.Context, r
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["swap"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("swap=%v", val))
+			queryParams = append(queryParams, "swap="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/role/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/role/%s%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -68,6 +74,7 @@ func DeleteprojectroleHandler(cfg *config.APIConfig) func(ctx context.Context, r
 func CreateDeleteprojectroleTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_role_id",
 		mcp.WithDescription("Deletes a role. May return 403 in the future"),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the role id")),
 		mcp.WithString("swap", mcp.Description("if given, removes a role even if it is used in scheme by replacing the role with the given one")),
 	)
 
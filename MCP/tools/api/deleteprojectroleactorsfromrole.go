@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,28 @@ func DeleteprojectroleactorsfromroleHandler(cfg *config.APIConfig) func(ctx cont
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["user"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("user=%v", val))
+			queryParams = append(queryParams, "user="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["group"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("group=%v", val))
+			queryParams = append(queryParams, "group="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/role/%s/actors%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/role/%s/actors%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -71,6 +77,7 @@ func DeleteprojectroleactorsfromroleHandler(cfg *config.APIConfig) func(ctx cont
 func CreateDeleteprojectroleactorsfromroleTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_role_id_actors",
 		mcp.WithDescription("Removes default actor from the given role."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the role id")),
 		mcp.WithString("user", mcp.Description("if given, removes an actor from given role")),
 		mcp.WithString("group", mcp.Description("if given, removes an actor from given role")),
 	)
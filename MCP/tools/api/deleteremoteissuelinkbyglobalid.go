@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func DeleteremoteissuelinkbyglobalidHandler(cfg *config.APIConfig) func(ctx cont
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["globalId"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("globalId=%v", val))
+			queryParams = append(queryParams, "globalId="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/issue/%s/remotelink%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		issueIdOrKey, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issue/%s/remotelink%s", cfg.BaseURL, url.PathEscape(issueIdOrKey), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -68,6 +74,7 @@ func DeleteremoteissuelinkbyglobalidHandler(cfg *config.APIConfig) func(ctx cont
 func CreateDeleteremoteissuelinkbyglobalidTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_issue_issueIdOrKey_remotelink",
 		mcp.WithDescription("Delete the remote issue link with the given global id on the issue."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("the issue id or key")),
 		mcp.WithString("globalId", mcp.Description("the global id of the remote issue link")),
 	)
 
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func DeleteremoteissuelinkbyidHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/issue/%s/remotelink/%s", cfg.BaseURL)
-		req, err := http.NewRequest("DELETE", url, nil)
+		issueIdOrKey, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		linkId, err := request.RequireString("linkId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issue/%s/remotelink/%s", cfg.BaseURL, url.PathEscape(issueIdOrKey), url.PathEscape(linkId))
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +65,8 @@ func DeleteremoteissuelinkbyidHandler(cfg *config.APIConfig) func(ctx context.Co
 func CreateDeleteremoteissuelinkbyidTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_issue_issueIdOrKey_remotelink_linkId",
 		mcp.WithDescription("Delete the remote issue link with the given id on the issue."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("the issue id or key")),
+		mcp.WithString("linkId", mcp.Required(), mcp.Description("the link id")),
 	)
 
 	return models.Tool{
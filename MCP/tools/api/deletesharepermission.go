@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func DeletesharepermissionHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/filter/%s/permission/%s", cfg.BaseURL)
-		req, err := http.NewRequest("DELETE", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		permissionId, err := request.RequireString("permission-id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/filter/%s/permission/%s", cfg.BaseURL, url.PathEscape(id), url.PathEscape(permissionId))
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +65,8 @@ func DeletesharepermissionHandler(cfg *config.APIConfig) func(ctx context.Contex
 func CreateDeletesharepermissionTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_filter_id_permission_permission-id",
 		mcp.WithDescription("Removes a share permissions from the given filter."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("")),
+		mcp.WithString("permission-id", mcp.Required(), mcp.Description("")),
 	)
 
 	return models.Tool{
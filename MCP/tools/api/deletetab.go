@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func DeletetabHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/screens/%s/tabs/%s", cfg.BaseURL)
-		req, err := http.NewRequest("DELETE", url, nil)
+		screenId, err := request.RequireString("screenId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tabId, err := request.RequireString("tabId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/screens/%s/tabs/%s", cfg.BaseURL, url.PathEscape(screenId), url.PathEscape(tabId))
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +65,8 @@ func DeletetabHandler(cfg *config.APIConfig) func(ctx context.Context, request m
 func CreateDeletetabTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_screens_screenId_tabs_tabId",
 		mcp.WithDescription("Deletes tab to give screen"),
+		mcp.WithString("screenId", mcp.Required(), mcp.Description("the screen id")),
+		mcp.WithString("tabId", mcp.Required(), mcp.Description("the tab id")),
 	)
 
 	return models.Tool{
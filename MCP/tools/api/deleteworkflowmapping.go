@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,28 @@ func DeleteworkflowmappingHandler(cfg *config.APIConfig) func(ctx context.Contex
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["workflowName"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("workflowName=%v", val))
+			queryParams = append(queryParams, "workflowName="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["updateDraftIfNeeded"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("updateDraftIfNeeded=%v", val))
+			queryParams = append(queryParams, "updateDraftIfNeeded="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/workflow%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/workflow%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -71,8 +77,9 @@ func DeleteworkflowmappingHandler(cfg *config.APIConfig) func(ctx context.Contex
 func CreateDeleteworkflowmappingTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_workflowscheme_id_workflow",
 		mcp.WithDescription("Delete the passed workflow from the workflow scheme."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the workflowscheme id")),
 		mcp.WithString("workflowName", mcp.Description("the name of the workflow to delete.")),
-		mcp.WithString("updateDraftIfNeeded", mcp.Description("flag to indicate if a draft should be created if necessary to delete the workflow\n                            from the scheme.")),
+		mcp.WithString("updateDraftIfNeeded", mcp.Description("flag to indicate if a draft should be created if necessary to delete the workflow from the scheme.")),
 	)
 
 	return models.Tool{
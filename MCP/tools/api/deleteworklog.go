@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,27 +23,35 @@ func DeleteworklogHandler(cfg *config.APIConfig) func(ctx context.Context, reque
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["adjustEstimate"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("adjustEstimate=%v", val))
+			queryParams = append(queryParams, "adjustEstimate="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["newEstimate"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("newEstimate=%v", val))
+			queryParams = append(queryParams, "newEstimate="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["increaseBy"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("increaseBy=%v", val))
+			queryParams = append(queryParams, "increaseBy="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/issue/%s/worklog/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		issueIdOrKey, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issue/%s/worklog/%s%s", cfg.BaseURL, url.PathEscape(issueIdOrKey), url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -74,7 +84,9 @@ func DeleteworklogHandler(cfg *config.APIConfig) func(ctx context.Context, reque
 func CreateDeleteworklogTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_issue_issueIdOrKey_worklog_id",
 		mcp.WithDescription("Deletes an existing worklog entry."),
-		mcp.WithString("adjustEstimate", mcp.Description("(optional) allows you to provide specific instructions to update the remaining time estimate of the issue.  Valid values are\n                       <ul>\n                       <li>\"new\" - sets the estimate to a specific value</li>\n                       <li>\"leave\"- leaves the estimate as is</li>\n                       <li>\"manual\" - specify a specific amount to increase remaining estimate by</li>\n                       <li>\"auto\"- Default option.  Will automatically adjust the value based on the new timeSpent specified on the worklog</li> </ul>")),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("the issue id or key")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the worklog id")),
+		mcp.WithString("adjustEstimate", mcp.Description("(optional) allows you to provide specific instructions to update the remaining time estimate of the issue.")),
 		mcp.WithString("newEstimate", mcp.Description("(required when \"new\" is selected for adjustEstimate) the new value for the remaining estimate field. e.g. \"2d\"")),
 		mcp.WithString("increaseBy", mcp.Description("(required when \"manual\" is selected for adjustEstimate) the amount to increase the remaining estimate by e.g. \"2d\"")),
 	)
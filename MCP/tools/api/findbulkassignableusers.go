@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,30 +23,30 @@ func FindbulkassignableusersHandler(cfg *config.APIConfig) func(ctx context.Cont
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["username"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("username=%v", val))
+			queryParams = append(queryParams, "username="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["projectKeys"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("projectKeys=%v", val))
+			queryParams = append(queryParams, "projectKeys="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["startAt"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("startAt=%v", val))
+			queryParams = append(queryParams, "startAt="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["maxResults"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("maxResults=%v", val))
+			queryParams = append(queryParams, "maxResults="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/user/assignable/multiProjectSearch%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -76,12 +78,11 @@ func FindbulkassignableusersHandler(cfg *config.APIConfig) func(ctx context.Cont
 
 func CreateFindbulkassignableusersTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_user_assignable_multiProjectSearch",
-		mcp.WithDescription("Returns a list of users that match the search string and can be assigned issues for all the given projects.
- This resource cannot be accessed anonymously."),
+		mcp.WithDescription("Returns a list of users that match the search string and can be assigned issues for all the given projects. This resource cannot be accessed anonymously."),
 		mcp.WithString("username", mcp.Description("the username")),
 		mcp.WithString("projectKeys", mcp.Description("the keys of the projects we are finding assignable users for, comma-separated")),
 		mcp.WithString("startAt", mcp.Description("the index of the first user to return (0-based)")),
-		mcp.WithString("maxResults", mcp.Description("the maximum number of users to return (defaults to 50). The maximum allowed value is 1000.\n                       If you specify a value that is higher than this number, your search results will be truncated.")),
+		mcp.WithString("maxResults", mcp.Description("the maximum number of users to return (defaults to 50). The maximum allowed value is 1000. If you specify a value that is higher than this number, your search results will be truncated.")),
 	)
 
 	return models.Tool{
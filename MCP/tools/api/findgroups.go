@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,30 +23,30 @@ func FindgroupsHandler(cfg *config.APIConfig) func(ctx context.Context, request
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["query"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("query=%v", val))
+			queryParams = append(queryParams, "query="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["exclude"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("exclude=%v", val))
+			queryParams = append(queryParams, "exclude="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["maxResults"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("maxResults=%v", val))
+			queryParams = append(queryParams, "maxResults="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["userName"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("userName=%v", val))
+			queryParams = append(queryParams, "userName="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/groups/picker%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -76,14 +78,7 @@ func FindgroupsHandler(cfg *config.APIConfig) func(ctx context.Context, request
 
 func CreateFindgroupsTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_groups_picker",
-		mcp.WithDescription("Returns groups with substrings matching a given query. This is mainly for use with
- the group picker, so the returned groups contain html to be used as picker suggestions.
- The groups are also wrapped in a single response object that also contains a header for
- use in the picker, specifically <i>Showing X of Y matching groups</i>.
- <p>
- The number of groups returned is limited by the system property "jira.ajax.autocomplete.limit"
- <p>
- The groups will be unique and sorted."),
+		mcp.WithDescription("Returns groups with substrings matching a given query. Full documentation: describe_jira_operation."),
 		mcp.WithString("query", mcp.Description("a String to match groups agains")),
 		mcp.WithString("exclude", mcp.Description("")),
 		mcp.WithString("maxResults", mcp.Description("")),
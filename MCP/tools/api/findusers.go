@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,33 +23,33 @@ func FindusersHandler(cfg *config.APIConfig) func(ctx context.Context, request m
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["username"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("username=%v", val))
+			queryParams = append(queryParams, "username="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["startAt"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("startAt=%v", val))
+			queryParams = append(queryParams, "startAt="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["maxResults"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("maxResults=%v", val))
+			queryParams = append(queryParams, "maxResults="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["includeActive"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("includeActive=%v", val))
+			queryParams = append(queryParams, "includeActive="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["includeInactive"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("includeInactive=%v", val))
+			queryParams = append(queryParams, "includeInactive="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/user/search%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -82,7 +84,7 @@ func CreateFindusersTool(cfg *config.APIConfig) models.Tool {
 		mcp.WithDescription("Returns a list of users that match the search string. This resource cannot be accessed anonymously."),
 		mcp.WithString("username", mcp.Description("A query string used to search username, name or e-mail address")),
 		mcp.WithString("startAt", mcp.Description("the index of the first user to return (0-based)")),
-		mcp.WithString("maxResults", mcp.Description("the maximum number of users to return (defaults to 50). The maximum allowed value is 1000.\n                        If you specify a value that is higher than this number, your search results will be truncated.")),
+		mcp.WithString("maxResults", mcp.Description("the maximum number of users to return (defaults to 50). The maximum allowed value is 1000. If you specify a value that is higher than this number, your search results will be truncated.")),
 		mcp.WithString("includeActive", mcp.Description("If true, then active users are included in the results (default true)")),
 		mcp.WithString("includeInactive", mcp.Description("If true, then inactive users are included in the results (default false)")),
 	)
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,36 +23,36 @@ func FindusersandgroupsHandler(cfg *config.APIConfig) func(ctx context.Context,
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["query"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("query=%v", val))
+			queryParams = append(queryParams, "query="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["maxResults"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("maxResults=%v", val))
+			queryParams = append(queryParams, "maxResults="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["showAvatar"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("showAvatar=%v", val))
+			queryParams = append(queryParams, "showAvatar="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["fieldId"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("fieldId=%v", val))
+			queryParams = append(queryParams, "fieldId="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["projectId"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("projectId=%v", val))
+			queryParams = append(queryParams, "projectId="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["issueTypeId"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("issueTypeId=%v", val))
+			queryParams = append(queryParams, "issueTypeId="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/groupuserpicker%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -82,14 +84,13 @@ func FindusersandgroupsHandler(cfg *config.APIConfig) func(ctx context.Context,
 
 func CreateFindusersandgroupsTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_groupuserpicker",
-		mcp.WithDescription("Returns a list of users and groups matching query with highlighting. This resource cannot be accessed
- anonymously."),
+		mcp.WithDescription("Returns a list of users and groups matching query with highlighting. This resource cannot be accessed anonymously."),
 		mcp.WithString("query", mcp.Description("A string used to search username, Name or e-mail address")),
-		mcp.WithString("maxResults", mcp.Description("the maximum number of users to return (defaults to 50). The maximum allowed value is 1000. If\n                    you specify a value that is higher than this number, your search results will be truncated.")),
+		mcp.WithString("maxResults", mcp.Description("the maximum number of users to return (defaults to 50). The maximum allowed value is 1000. If you specify a value that is higher than this number, your search results will be truncated.")),
 		mcp.WithString("showAvatar", mcp.Description("")),
 		mcp.WithString("fieldId", mcp.Description("The custom field id, if this request comes from a custom field, such as a user picker. Optional.")),
-		mcp.WithString("projectId", mcp.Description("The list of project ids to further restrict the search\n                    This parameter can occur multiple times to pass in multiple project ids.\n                    Comma separated value is not supported.\n                    This parameter is only used when fieldId is present.")),
-		mcp.WithString("issueTypeId", mcp.Description("The list of issue type ids to further restrict the search.\n                    This parameter can occur multiple times to pass in multiple issue type ids.\n                    Comma separated value is not supported.\n                    Special values such as -1 (all standard issue types), -2 (all subtask issue types) are supported.\n                    This parameter is only used when fieldId is present.")),
+		mcp.WithString("projectId", mcp.Description("The list of project ids to further restrict the search This parameter can occur multiple times to pass in multiple project ids. Comma separated value is not supported. This parameter is only used when fieldId is present.")),
+		mcp.WithString("issueTypeId", mcp.Description("The list of issue type ids to further restrict the search.")),
 	)
 
 	return models.Tool{
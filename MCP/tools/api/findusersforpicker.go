@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,30 +23,30 @@ func FindusersforpickerHandler(cfg *config.APIConfig) func(ctx context.Context,
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["query"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("query=%v", val))
+			queryParams = append(queryParams, "query="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["maxResults"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("maxResults=%v", val))
+			queryParams = append(queryParams, "maxResults="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["showAvatar"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("showAvatar=%v", val))
+			queryParams = append(queryParams, "showAvatar="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["exclude"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("exclude=%v", val))
+			queryParams = append(queryParams, "exclude="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/user/picker%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -78,7 +80,7 @@ func CreateFindusersforpickerTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_user_picker",
 		mcp.WithDescription("Returns a list of users matching query with highlighting. This resource cannot be accessed anonymously."),
 		mcp.WithString("query", mcp.Description("A string used to search username, Name or e-mail address")),
-		mcp.WithString("maxResults", mcp.Description("the maximum number of users to return (defaults to 50). The maximum allowed value is 1000.\n                   If you specify a value that is higher than this number, your search results will be truncated.")),
+		mcp.WithString("maxResults", mcp.Description("the maximum number of users to return (defaults to 50). The maximum allowed value is 1000. If you specify a value that is higher than this number, your search results will be truncated.")),
 		mcp.WithString("showAvatar", mcp.Description("")),
 		mcp.WithString("exclude", mcp.Description("")),
 	)
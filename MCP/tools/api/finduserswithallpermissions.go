@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,36 +23,36 @@ func FinduserswithallpermissionsHandler(cfg *config.APIConfig) func(ctx context.
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["username"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("username=%v", val))
+			queryParams = append(queryParams, "username="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["permissions"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("permissions=%v", val))
+			queryParams = append(queryParams, "permissions="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["issueKey"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("issueKey=%v", val))
+			queryParams = append(queryParams, "issueKey="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["projectKey"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("projectKey=%v", val))
+			queryParams = append(queryParams, "projectKey="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["startAt"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("startAt=%v", val))
+			queryParams = append(queryParams, "startAt="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["maxResults"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("maxResults=%v", val))
+			queryParams = append(queryParams, "maxResults="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/user/permission/search%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -82,14 +84,13 @@ func FinduserswithallpermissionsHandler(cfg *config.APIConfig) func(ctx context.
 
 func CreateFinduserswithallpermissionsTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_user_permission_search",
-		mcp.WithDescription("Returns a list of active users that match the search string and have all specified permissions for the project or issue.<br>
- This resource can be accessed by users with ADMINISTER_PROJECT permission for the project or global ADMIN or SYSADMIN rights."),
+		mcp.WithDescription("Returns a list of active users that match the search string and have all specified permissions for the project or issue. Full documentation: describe_jira_operation."),
 		mcp.WithString("username", mcp.Description("the username filter, list includes all users if unspecified")),
-		mcp.WithString("permissions", mcp.Description("comma separated list of permissions for project or issue returned users must have, see\n                    <a href=\"https://developer.atlassian.com/static/javadoc/jira/6.0/reference/com/atlassian/jira/security/Permissions.Permission.html\">Permissions</a>\n                    JavaDoc for the list of all possible permissions.")),
+		mcp.WithString("permissions", mcp.Description("comma separated list of permissions for project or issue returned users must have, see [Permissions](https://developer.atlassian.com/static/javadoc/jira/6.0/reference/com/atlassian/jira/security/Permissions.Permission.html) JavaDoc for the list of all possible permissions.")),
 		mcp.WithString("issueKey", mcp.Description("the issue key for the issue for which returned users have specified permissions.")),
 		mcp.WithString("projectKey", mcp.Description("the optional project key to search for users with if no issueKey is supplied.")),
 		mcp.WithString("startAt", mcp.Description("the index of the first user to return (0-based)")),
-		mcp.WithString("maxResults", mcp.Description("the maximum number of users to return (defaults to 50). The maximum allowed value is 1000.\n                    If you specify a value that is higher than this number, your search results will be truncated.")),
+		mcp.WithString("maxResults", mcp.Description("the maximum number of users to return (defaults to 50). The maximum allowed value is 1000. If you specify a value that is higher than this number, your search results will be truncated.")),
 	)
 
 	return models.Tool{
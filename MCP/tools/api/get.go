@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func GetHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/applicationrole/%s", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		key, err := request.RequireString("key")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/applicationrole/%s", cfg.BaseURL, url.PathEscape(key))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func GetHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.Cal
 func CreateGetTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_applicationrole_key",
 		mcp.WithDescription("Returns the ApplicationRole with passed key if it exists."),
+		mcp.WithString("key", mcp.Required(), mcp.Description("the applicationrole key")),
 	)
 
 	return models.Tool{
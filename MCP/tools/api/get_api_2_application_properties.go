@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,27 +23,27 @@ func Get_api_2_application_propertiesHandler(cfg *config.APIConfig) func(ctx con
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["key"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("key=%v", val))
+			queryParams = append(queryParams, "key="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["permissionLevel"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("permissionLevel=%v", val))
+			queryParams = append(queryParams, "permissionLevel="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["keyFilter"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("keyFilter=%v", val))
+			queryParams = append(queryParams, "keyFilter="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/application-properties%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -75,8 +77,8 @@ func CreateGet_api_2_application_propertiesTool(cfg *config.APIConfig) models.To
 	tool := mcp.NewTool("get_api_2_application-properties",
 		mcp.WithDescription("Returns an application property."),
 		mcp.WithString("key", mcp.Description("a String containing the property key")),
-		mcp.WithString("permissionLevel", mcp.Description("when fetching a list specifies the permission level of all items in the list\n                        see {@link com.atlassian.jira.bc.admin.ApplicationPropertiesService.EditPermissionLevel}")),
-		mcp.WithString("keyFilter", mcp.Description("when fetching a list allows the list to be filtered by the property's start of key\n                        e.g. \"jira.lf.*\" whould fetch only those permissions that are editable and whose keys start with\n                        \"jira.lf.\". This is a regex.")),
+		mcp.WithString("permissionLevel", mcp.Description("when fetching a list specifies the permission level of all items in the list see `EditPermissionLevel`")),
+		mcp.WithString("keyFilter", mcp.Description("when fetching a list allows the list to be filtered by the property's start of key e.g. \"jira.lf.*\" whould fetch only those permissions that are editable and whose keys start with \"jira.lf.\". This is a regex.")),
 	)
 
 	return models.Tool{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func Get_api_2_comment_commentid_propertiesHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/comment/%s/properties", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		commentId, err := request.RequireString("commentId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/comment/%s/properties", cfg.BaseURL, url.PathEscape(commentId))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func Get_api_2_comment_commentid_propertiesHandler(cfg *config.APIConfig) func(c
 func CreateGet_api_2_comment_commentid_propertiesTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_comment_commentId_properties",
 		mcp.WithDescription("Returns the keys of all properties for the comment identified by the key or by the id."),
+		mcp.WithString("commentId", mcp.Required(), mcp.Description("the comment from which keys will be returned.")),
 	)
 
 	return models.Tool{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func Get_api_2_filter_id_columnsHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/filter/%s/columns", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/filter/%s/columns", cfg.BaseURL, url.PathEscape(id))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,8 +60,8 @@ func Get_api_2_filter_id_columnsHandler(cfg *config.APIConfig) func(ctx context.
 
 func CreateGet_api_2_filter_id_columnsTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_filter_id_columns",
-		mcp.WithDescription("Returns the default columns for the given filter. Currently logged in user will be used as
- the user making such request."),
+		mcp.WithDescription("Returns the default columns for the given filter. Currently logged in user will be used as the user making such request."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the filter id")),
 	)
 
 	return models.Tool{
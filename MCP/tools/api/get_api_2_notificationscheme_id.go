@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func Get_api_2_notificationscheme_idHandler(cfg *config.APIConfig) func(ctx cont
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/notificationscheme/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/notificationscheme/%s%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,33 +73,8 @@ func Get_api_2_notificationscheme_idHandler(cfg *config.APIConfig) func(ctx cont
 
 func CreateGet_api_2_notificationscheme_idTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_notificationscheme_id",
-		mcp.WithDescription("Returns a full representation of the notification scheme for the given id. This resource will return a
- notification scheme containing a list of events and recipient configured to receive notifications for these events. Consumer
- should allow events without recipients to appear in response. User accessing
- the data is required to have permissions to administer at least one project associated with the requested notification scheme.
- <p>
- Notification recipients can be:
- <ul>
- <li>current assignee - the value of the notificationType is CurrentAssignee</li>
- <li>issue reporter - the value of the notificationType is Reporter</li>
- <li>current user - the value of the notificationType is CurrentUser</li>
- <li>project lead - the value of the notificationType is ProjectLead</li>
- <li>component lead - the value of the notificationType is ComponentLead</li>
- <li>all watchers - the value of the notification type is AllWatchers</li>
- <li>configured user - the value of the notification type is User. Parameter will contain key of the user. Information about the user will be provided
- if <b>user</b> expand parameter is used. </li>
- <li>configured group - the value of the notification type is Group. Parameter will contain name of the group. Information about the group will be provided
- if <b>group</b> expand parameter is used. </li>
- <li>configured email address - the value of the notification type is EmailAddress, additionally information about the email will be provided.</li>
- <li>users or users in groups in the configured custom fields - the value of the notification type is UserCustomField or GroupCustomField. Parameter
- will contain id of the custom field. Information about the field will be provided if <b>field</b> expand parameter is used. </li>
- <li>configured project role - the value of the notification type is ProjectRole. Parameter will contain project role id. Information about the project role
- will be provided if <b>projectRole</b> expand parameter is used. </li>
- </ul>
- Please see the example for reference.
- </p>
- The events can be JIRA system events or events configured by administrator. In case of the system events, data about theirs
- ids, names and descriptions is provided. In case of custom events, the template event is included as well."),
+		mcp.WithDescription("Returns a full representation of the notification scheme for the given id. Full documentation: describe_jira_operation."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("an id of the notification scheme to retrieve")),
 		mcp.WithString("expand", mcp.Description("")),
 	)
 
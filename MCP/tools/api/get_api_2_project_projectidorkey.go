@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func Get_api_2_project_projectidorkeyHandler(cfg *config.APIConfig) func(ctx con
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/project/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		projectIdOrKey, err := request.RequireString("projectIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/%s%s", cfg.BaseURL, url.PathEscape(projectIdOrKey), queryString)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,10 +73,8 @@ func Get_api_2_project_projectidorkeyHandler(cfg *config.APIConfig) func(ctx con
 
 func CreateGet_api_2_project_projectidorkeyTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_project_projectIdOrKey",
-		mcp.WithDescription("Contains a full representation of a project in JSON format.
- <p>
- All project keys associated with the project will only be returned if <code>expand=projectKeys</code>.
- <p>"),
+		mcp.WithDescription("Contains a full representation of a project in JSON format. Full documentation: describe_jira_operation."),
+		mcp.WithString("projectIdOrKey", mcp.Required(), mcp.Description("the project id or key")),
 		mcp.WithString("expand", mcp.Description("the parameters to expand")),
 	)
 
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func Get_api_2_project_projectidorkey_avatarsHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/project/%s/avatars", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		projectIdOrKey, err := request.RequireString("projectIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/%s/avatars", cfg.BaseURL, url.PathEscape(projectIdOrKey))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,8 +60,8 @@ func Get_api_2_project_projectidorkey_avatarsHandler(cfg *config.APIConfig) func
 
 func CreateGet_api_2_project_projectidorkey_avatarsTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_project_projectIdOrKey_avatars",
-		mcp.WithDescription("Returns all avatars which are visible for the currently logged in user.  The avatars are grouped into
- system and custom."),
+		mcp.WithDescription("Returns all avatars which are visible for the currently logged in user. The avatars are grouped into system and custom."),
+		mcp.WithString("projectIdOrKey", mcp.Required(), mcp.Description("project id or project key")),
 	)
 
 	return models.Tool{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func Get_api_2_project_projectkeyorid_issuesecuritylevelschemeHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/project/%s/issuesecuritylevelscheme", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		projectKeyOrId, err := request.RequireString("projectKeyOrId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/%s/issuesecuritylevelscheme", cfg.BaseURL, url.PathEscape(projectKeyOrId))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func Get_api_2_project_projectkeyorid_issuesecuritylevelschemeHandler(cfg *confi
 func CreateGet_api_2_project_projectkeyorid_issuesecuritylevelschemeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_project_projectKeyOrId_issuesecuritylevelscheme",
 		mcp.WithDescription("Returns the issue security scheme for project."),
+		mcp.WithString("projectKeyOrId", mcp.Required(), mcp.Description("")),
 	)
 
 	return models.Tool{
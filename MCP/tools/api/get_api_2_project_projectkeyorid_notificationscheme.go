@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func Get_api_2_project_projectkeyorid_notificationschemeHandler(cfg *config.APIC
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/project/%s/notificationscheme%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		projectKeyOrId, err := request.RequireString("projectKeyOrId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/%s/notificationscheme%s", cfg.BaseURL, url.PathEscape(projectKeyOrId), queryString)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,8 +73,8 @@ func Get_api_2_project_projectkeyorid_notificationschemeHandler(cfg *config.APIC
 
 func CreateGet_api_2_project_projectkeyorid_notificationschemeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_project_projectKeyOrId_notificationscheme",
-		mcp.WithDescription("Gets a notification scheme associated with the project.
- Follow the documentation of /notificationscheme/{id} resource for all details about returned value."),
+		mcp.WithDescription("Gets a notification scheme associated with the project. Follow the documentation of /notificationscheme/{id} resource for all details about returned value."),
+		mcp.WithString("projectKeyOrId", mcp.Required(), mcp.Description("key or id of the project")),
 		mcp.WithString("expand", mcp.Description("")),
 	)
 
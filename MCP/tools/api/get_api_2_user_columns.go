@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,21 @@ func Get_api_2_user_columnsHandler(cfg *config.APIConfig) func(ctx context.Conte
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["username"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("username=%v", val))
+			queryParams = append(queryParams, "username="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/user/columns%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,8 +69,7 @@ func Get_api_2_user_columnsHandler(cfg *config.APIConfig) func(ctx context.Conte
 
 func CreateGet_api_2_user_columnsTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_user_columns",
-		mcp.WithDescription("Returns the default columns for the given user. Admin permission will be required to get columns for a user
- other than the currently logged in user."),
+		mcp.WithDescription("Returns the default columns for the given user. Admin permission will be required to get columns for a user other than the currently logged in user."),
 		mcp.WithString("username", mcp.Description("username")),
 	)
 
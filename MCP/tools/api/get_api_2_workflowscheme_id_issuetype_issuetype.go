@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,29 @@ func Get_api_2_workflowscheme_id_issuetype_issuetypeHandler(cfg *config.APIConfi
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["returnDraftIfExists"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("returnDraftIfExists=%v", val))
+			queryParams = append(queryParams, "returnDraftIfExists="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/issuetype/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		issueType, err := request.RequireString("issueType")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/issuetype/%s%s", cfg.BaseURL, url.PathEscape(id), url.PathEscape(issueType), queryString)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -68,7 +78,9 @@ func Get_api_2_workflowscheme_id_issuetype_issuetypeHandler(cfg *config.APIConfi
 func CreateGet_api_2_workflowscheme_id_issuetype_issuetypeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_workflowscheme_id_issuetype_issueType",
 		mcp.WithDescription("Returns the issue type mapping for the passed workflow scheme."),
-		mcp.WithString("returnDraftIfExists", mcp.Description("when true indicates that a scheme's draft, if it exists, should be queried instead of\n                            the scheme itself.")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the workflowscheme id")),
+		mcp.WithString("issueType", mcp.Required(), mcp.Description("the issue type")),
+		mcp.WithString("returnDraftIfExists", mcp.Description("when true indicates that a scheme's draft, if it exists, should be queried instead of the scheme itself.")),
 	)
 
 	return models.Tool{
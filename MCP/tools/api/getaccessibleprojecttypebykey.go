@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func GetaccessibleprojecttypebykeyHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/project/type/%s/accessible", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		projectTypeKey, err := request.RequireString("projectTypeKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/type/%s/accessible", cfg.BaseURL, url.PathEscape(projectTypeKey))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,8 +60,8 @@ func GetaccessibleprojecttypebykeyHandler(cfg *config.APIConfig) func(ctx contex
 
 func CreateGetaccessibleprojecttypebykeyTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_project_type_projectTypeKey_accessible",
-		mcp.WithDescription("Returns the project type with the given key, if it is accessible to the logged in user.
- This takes into account whether the user is licensed on the Application that defines the project type."),
+		mcp.WithDescription("Returns the project type with the given key, if it is accessible to the logged in user. This takes into account whether the user is licensed on the Application that defines the project type."),
+		mcp.WithString("projectTypeKey", mcp.Required(), mcp.Description("")),
 	)
 
 	return models.Tool{
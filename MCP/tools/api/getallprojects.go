@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,24 @@ func GetallprojectsHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["recent"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("recent=%v", val))
+			queryParams = append(queryParams, "recent="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/project%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -70,8 +72,7 @@ func GetallprojectsHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 
 func CreateGetallprojectsTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_project",
-		mcp.WithDescription("Returns all projects which are visible for the currently logged in user. If no user is logged in, it returns the
- list of projects that are visible when using anonymous access."),
+		mcp.WithDescription("Returns all projects which are visible for the currently logged in user. If no user is logged in, it returns the list of projects that are visible when using anonymous access."),
 		mcp.WithString("expand", mcp.Description("the parameters to expand")),
 		mcp.WithString("recent", mcp.Description("if this parameter is set then only projects recently accessed by the current user (if not logged in then based on HTTP session) will be returned (maximum count limited to the specified number but no more than 20).")),
 	)
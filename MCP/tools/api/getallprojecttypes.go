@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,14 +16,14 @@ import (
 func GetallprojecttypesHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		url := fmt.Sprintf("%s/api/2/project/type", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,8 +55,7 @@ func GetallprojecttypesHandler(cfg *config.APIConfig) func(ctx context.Context,
 
 func CreateGetallprojecttypesTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_project_type",
-		mcp.WithDescription("Returns all the project types defined on the JIRA instance, not taking into account whether
- the license to use those project types is valid or not."),
+		mcp.WithDescription("Returns all the project types defined on the JIRA instance, not taking into account whether the license to use those project types is valid or not."),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func GetalltabsHandler(cfg *config.APIConfig) func(ctx context.Context, request
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["projectKey"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("projectKey=%v", val))
+			queryParams = append(queryParams, "projectKey="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/screens/%s/tabs%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		screenId, err := request.RequireString("screenId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/screens/%s/tabs%s", cfg.BaseURL, url.PathEscape(screenId), queryString)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -68,6 +74,7 @@ func GetalltabsHandler(cfg *config.APIConfig) func(ctx context.Context, request
 func CreateGetalltabsTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_screens_screenId_tabs",
 		mcp.WithDescription("Returns a list of all tabs for the given screen"),
+		mcp.WithString("screenId", mcp.Required(), mcp.Description("the screen id")),
 		mcp.WithString("projectKey", mcp.Description("the key of the project; this parameter is optional")),
 	)
 
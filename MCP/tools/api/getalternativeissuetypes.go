@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func GetalternativeissuetypesHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/issuetype/%s/alternatives", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issuetype/%s/alternatives", cfg.BaseURL, url.PathEscape(id))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,9 +60,8 @@ func GetalternativeissuetypesHandler(cfg *config.APIConfig) func(ctx context.Con
 
 func CreateGetalternativeissuetypesTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_issuetype_id_alternatives",
-		mcp.WithDescription("Returns a list of all alternative issue types for the given issue type id. The list will contain these issues types, to which
- issues assigned to the given issue type can be migrated. The suitable alternatives are issue types which are assigned
- to the same workflow, the same field configuration and the same screen scheme."),
+		mcp.WithDescription("Returns a list of all alternative issue types for the given issue type id. Full documentation: describe_jira_operation."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("")),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func GetassignedpermissionschemeHandler(cfg *config.APIConfig) func(ctx context.
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/project/%s/permissionscheme%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		projectKeyOrId, err := request.RequireString("projectKeyOrId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/%s/permissionscheme%s", cfg.BaseURL, url.PathEscape(projectKeyOrId), queryString)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -68,6 +74,7 @@ func GetassignedpermissionschemeHandler(cfg *config.APIConfig) func(ctx context.
 func CreateGetassignedpermissionschemeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_project_projectKeyOrId_permissionscheme",
 		mcp.WithDescription("Gets a permission scheme assigned with a project."),
+		mcp.WithString("projectKeyOrId", mcp.Required(), mcp.Description("the project key or id")),
 		mcp.WithString("expand", mcp.Description("")),
 	)
 
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,14 +16,14 @@ import (
 func GetattachmentmetaHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		url := fmt.Sprintf("%s/api/2/attachment/meta", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,8 +55,7 @@ func GetattachmentmetaHandler(cfg *config.APIConfig) func(ctx context.Context, r
 
 func CreateGetattachmentmetaTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_attachment_meta",
-		mcp.WithDescription("Returns the meta information for an attachments, specifically if they are enabled and the maximum upload size
- allowed."),
+		mcp.WithDescription("Returns the meta information for an attachments, specifically if they are enabled and the maximum upload size allowed."),
 	)
 
 	return models.Tool{
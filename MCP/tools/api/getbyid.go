@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func GetbyidHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["returnDraftIfExists"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("returnDraftIfExists=%v", val))
+			queryParams = append(queryParams, "returnDraftIfExists="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/workflowscheme/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/workflowscheme/%s%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -68,7 +74,8 @@ func GetbyidHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp
 func CreateGetbyidTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_workflowscheme_id",
 		mcp.WithDescription("Returns the requested workflow scheme to the caller."),
-		mcp.WithString("returnDraftIfExists", mcp.Description("when true indicates that a scheme's draft, if it exists, should be queried instead of\n                            the scheme itself.")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the workflowscheme id")),
+		mcp.WithString("returnDraftIfExists", mcp.Description("when true indicates that a scheme's draft, if it exists, should be queried instead of the scheme itself.")),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,30 +23,34 @@ func GetcommentsHandler(cfg *config.APIConfig) func(ctx context.Context, request
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["startAt"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("startAt=%v", val))
+			queryParams = append(queryParams, "startAt="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["maxResults"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("maxResults=%v", val))
+			queryParams = append(queryParams, "maxResults="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["orderBy"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("orderBy=%v", val))
+			queryParams = append(queryParams, "orderBy="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/issue/%s/comment%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		issueIdOrKey, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issue/%s/comment%s", cfg.BaseURL, url.PathEscape(issueIdOrKey), queryString)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -76,10 +82,8 @@ func GetcommentsHandler(cfg *config.APIConfig) func(ctx context.Context, request
 
 func CreateGetcommentsTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_issue_issueIdOrKey_comment",
-		mcp.WithDescription("Returns all comments for an issue.
- <p>
- Results can be ordered by the "created" field which means the date a comment was added.
- </p>"),
+		mcp.WithDescription("Returns all comments for an issue. Full documentation: describe_jira_operation."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("the issue id or key")),
 		mcp.WithString("startAt", mcp.Description("the page offset, if not specified then defaults to 0")),
 		mcp.WithString("maxResults", mcp.Description("how many results on the page should be included. Defaults to 50.")),
 		mcp.WithString("orderBy", mcp.Description("ordering of the results.")),
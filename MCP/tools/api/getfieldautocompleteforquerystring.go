@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,30 +23,30 @@ func GetfieldautocompleteforquerystringHandler(cfg *config.APIConfig) func(ctx c
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["fieldName"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("fieldName=%v", val))
+			queryParams = append(queryParams, "fieldName="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["fieldValue"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("fieldValue=%v", val))
+			queryParams = append(queryParams, "fieldValue="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["predicateName"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("predicateName=%v", val))
+			queryParams = append(queryParams, "predicateName="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["predicateValue"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("predicateValue=%v", val))
+			queryParams = append(queryParams, "predicateValue="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/jql/autocompletedata/suggestions%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func GetfieldstoaddHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/screens/%s/availableFields", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		screenId, err := request.RequireString("screenId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/screens/%s/availableFields", cfg.BaseURL, url.PathEscape(screenId))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func GetfieldstoaddHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 func CreateGetfieldstoaddTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_screens_screenId_availableFields",
 		mcp.WithDescription("Gets available fields for screen. i.e ones that haven't already been added."),
+		mcp.WithString("screenId", mcp.Required(), mcp.Description("id of screen")),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,28 @@ func GetfilterHandler(cfg *config.APIConfig) func(ctx context.Context, request m
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["enableSharedUsers"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("enableSharedUsers=%v", val))
+			queryParams = append(queryParams, "enableSharedUsers="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/filter/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/filter/%s%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -71,6 +77,7 @@ func GetfilterHandler(cfg *config.APIConfig) func(ctx context.Context, request m
 func CreateGetfilterTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_filter_id",
 		mcp.WithDescription("Returns a filter given an id"),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the filter id")),
 		mcp.WithString("expand", mcp.Description("the parameters to expand")),
 		mcp.WithString("enableSharedUsers", mcp.Description("enable calculating shared users collection")),
 	)
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,24 @@ func GetgroupHandler(cfg *config.APIConfig) func(ctx context.Context, request mc
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["groupname"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("groupname=%v", val))
+			queryParams = append(queryParams, "groupname="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/group%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -70,12 +72,7 @@ func GetgroupHandler(cfg *config.APIConfig) func(ctx context.Context, request mc
 
 func CreateGetgroupTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_group",
-		mcp.WithDescription("Returns REST representation for the requested group. Allows to get list of active users belonging to the
- specified group and its subgroups if "users" expand option is provided. You can page through users list by using
- indexes in expand param. For example to get users from index 10 to index 15 use "users[10:15]" expand value. This
- will return 6 users (if there are at least 16 users in this group). Indexes are 0-based and inclusive.
- <p>
- This resource is deprecated, please use group/member API instead."),
+		mcp.WithDescription("Returns REST representation for the requested group. Full documentation: describe_jira_operation."),
 		mcp.WithString("groupname", mcp.Description("A name of requested group.")),
 		mcp.WithString("expand", mcp.Description("List of fields to expand. Currently only available expand is \"users\".")),
 	)
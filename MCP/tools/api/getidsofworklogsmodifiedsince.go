@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,21 @@ func GetidsofworklogsmodifiedsinceHandler(cfg *config.APIConfig) func(ctx contex
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["since"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("since=%v", val))
+			queryParams = append(queryParams, "since="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/worklog/updated%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,9 +69,7 @@ func GetidsofworklogsmodifiedsinceHandler(cfg *config.APIConfig) func(ctx contex
 
 func CreateGetidsofworklogsmodifiedsinceTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_worklog_updated",
-		mcp.WithDescription("Returns worklogs id and update time of worklogs that was updated since given time.
- The returns set of worklogs is limited to 1000 elements.
- This API will not return worklogs updated during last minute."),
+		mcp.WithDescription("Returns worklogs id and update time of worklogs that was updated since given time. The returns set of worklogs is limited to 1000 elements. This API will not return worklogs updated during last minute."),
 		mcp.WithString("since", mcp.Description("a date time in unix timestamp format since when updated worklogs will be returned.")),
 	)
 
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func GetissuelinkHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/issueLink/%s", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		linkId, err := request.RequireString("linkId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issueLink/%s", cfg.BaseURL, url.PathEscape(linkId))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func GetissuelinkHandler(cfg *config.APIConfig) func(ctx context.Context, reques
 func CreateGetissuelinkTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_issueLink_linkId",
 		mcp.WithDescription("Returns an issue link with the specified id."),
+		mcp.WithString("linkId", mcp.Required(), mcp.Description("the link id")),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,36 +23,36 @@ func GetissuepickerresourceHandler(cfg *config.APIConfig) func(ctx context.Conte
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["query"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("query=%v", val))
+			queryParams = append(queryParams, "query="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["currentJQL"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("currentJQL=%v", val))
+			queryParams = append(queryParams, "currentJQL="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["currentIssueKey"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("currentIssueKey=%v", val))
+			queryParams = append(queryParams, "currentIssueKey="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["currentProjectId"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("currentProjectId=%v", val))
+			queryParams = append(queryParams, "currentProjectId="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["showSubTasks"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("showSubTasks=%v", val))
+			queryParams = append(queryParams, "showSubTasks="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["showSubTaskParent"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("showSubTaskParent=%v", val))
+			queryParams = append(queryParams, "showSubTaskParent="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/issue/picker%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -82,8 +84,7 @@ func GetissuepickerresourceHandler(cfg *config.APIConfig) func(ctx context.Conte
 
 func CreateGetissuepickerresourceTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_issue_picker",
-		mcp.WithDescription("Returns suggested issues which match the auto-completion query for the user which executes this request. This REST
- method will check the user's history and the user's browsing context and select this issues, which match the query."),
+		mcp.WithDescription("Returns suggested issues which match the auto-completion query for the user which executes this request. This REST method will check the user's history and the user's browsing context and select this issues, which match the query."),
 		mcp.WithString("query", mcp.Description("the query.")),
 		mcp.WithString("currentJQL", mcp.Description("the JQL in context of which the request is executed. Only issues which match this JQL query will be included in results.")),
 		mcp.WithString("currentIssueKey", mcp.Description("the key of the issue in context of which the request is executed. The issue which is in context will not be included in the auto-completion result, even if it matches the query.")),
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,27 +23,27 @@ func GetnotificationschemesHandler(cfg *config.APIConfig) func(ctx context.Conte
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["startAt"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("startAt=%v", val))
+			queryParams = append(queryParams, "startAt="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["maxResults"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("maxResults=%v", val))
+			queryParams = append(queryParams, "maxResults="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/notificationscheme%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -73,11 +75,7 @@ func GetnotificationschemesHandler(cfg *config.APIConfig) func(ctx context.Conte
 
 func CreateGetnotificationschemesTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_notificationscheme",
-		mcp.WithDescription("Returns a <a href="#pagination">paginated</a> list of notification schemes. In order to access notification scheme, the calling user is
- required to have permissions to administer at least one project associated with the requested notification scheme. Each scheme contains
- a list of events and recipient configured to receive notifications for these events. Consumer should allow events without recipients to appear in response.
- The list is ordered by the scheme's name.
- Follow the documentation of /notificationscheme/{id} resource for all details about returned value."),
+		mcp.WithDescription("Returns a [paginated](#pagination) list of notification schemes. Full documentation: describe_jira_operation."),
 		mcp.WithString("startAt", mcp.Description("the index of the first notification scheme to return (0 based).")),
 		mcp.WithString("maxResults", mcp.Description("the maximum number of notification schemes to return (max 50).")),
 		mcp.WithString("expand", mcp.Description("")),
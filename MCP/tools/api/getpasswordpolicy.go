@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,21 @@ func GetpasswordpolicyHandler(cfg *config.APIConfig) func(ctx context.Context, r
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["hasOldPassword"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("hasOldPassword=%v", val))
+			queryParams = append(queryParams, "hasOldPassword="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/password/policy%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,9 +69,8 @@ func GetpasswordpolicyHandler(cfg *config.APIConfig) func(ctx context.Context, r
 
 func CreateGetpasswordpolicyTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_password_policy",
-		mcp.WithDescription("Returns the list of requirements for the current password policy. For example, "The password must have at least 10 characters.",
- "The password must not be similar to the user's name or email address.", etc."),
-		mcp.WithString("hasOldPassword", mcp.Description("whether or not the user will be required to enter their current password.  Use\n                       {@code false} (the default) if this is a new user or if an administrator is forcibly changing\n                       another user's password.")),
+		mcp.WithDescription("Returns the list of requirements for the current password policy. For example, \"The password must have at least 10 characters.\", \"The password must not be similar to the user's name or email address.\", etc."),
+		mcp.WithString("hasOldPassword", mcp.Description("whether or not the user will be required to enter their current password. Use `false` (the default) if this is a new user or if an administrator is forcibly changing another user's password.")),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,30 +23,30 @@ func GetpermissionsHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["projectKey"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("projectKey=%v", val))
+			queryParams = append(queryParams, "projectKey="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["projectId"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("projectId=%v", val))
+			queryParams = append(queryParams, "projectId="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["issueKey"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("issueKey=%v", val))
+			queryParams = append(queryParams, "issueKey="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["issueId"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("issueId=%v", val))
+			queryParams = append(queryParams, "issueId="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/mypermissions%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -76,28 +78,7 @@ func GetpermissionsHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 
 func CreateGetpermissionsTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_mypermissions",
-		mcp.WithDescription("Returns all permissions in the system and whether the currently logged in user has them. You can optionally provide a specific context to get permissions for
- (projectKey OR projectId OR issueKey OR issueId)
- <ul>
- <li> When no context supplied the project related permissions will return true if the user has that permission in ANY project </li>
- <li> If a project context is provided, project related permissions will return true if the user has the permissions in the specified project.
- For permissions that are determined using issue data (e.g Current Assignee), true will be returned if the user meets the permission criteria in ANY issue in that project </li>
- <li> If an issue context is provided, it will return whether or not the user has each permission in that specific issue</li>
- </ul>
- <p>
- NB: The above means that for issue-level permissions (EDIT_ISSUE for example), hasPermission may be true when no context is provided, or when a project context is provided,
- <b>but</b> may be false for any given (or all) issues. This would occur (for example) if Reporters were given the EDIT_ISSUE permission. This is because
- any user could be a reporter, except in the context of a concrete issue, where the reporter is known.
- </p>
- <p>
- Global permissions will still be returned for all scopes.
- </p>
- <p>
- Prior to version 6.4 this service returned project permissions with keys corresponding to com.atlassian.jira.security.Permissions.Permission constants.
- Since 6.4 those keys are considered deprecated and this service returns system project permission keys corresponding to constants defined in com.atlassian.jira.permission.ProjectPermissions.
- Permissions with legacy keys are still also returned for backwards compatibility, they are marked with an attribute deprecatedKey=true.
- The attribute is missing for project permissions with the current keys.
- </p>"),
+		mcp.WithDescription("Returns all permissions in the system and whether the currently logged in user has them. You can optionally provide a specific context to get permissions for (projectKey OR projectId OR issueKey OR issueId) Full documentation: describe_jira_operation."),
 		mcp.WithString("projectKey", mcp.Description("- key of project to scope returned permissions for.")),
 		mcp.WithString("projectId", mcp.Description("- id of project to scope returned permissions for.")),
 		mcp.WithString("issueKey", mcp.Description("- key of the issue to scope returned permissions for.")),
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,21 @@ func GetpermissionschemesHandler(cfg *config.APIConfig) func(ctx context.Context
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/permissionscheme%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,12 +69,7 @@ func GetpermissionschemesHandler(cfg *config.APIConfig) func(ctx context.Context
 
 func CreateGetpermissionschemesTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_permissionscheme",
-		mcp.WithDescription("Returns a list of all permission schemes.
- <p>
- By default only shortened beans are returned. If you want to include permissions of all the schemes,
- then specify the <b>permissions</b> expand parameter. Permissions will be included also if you specify
- any other expand parameter.
- </p>"),
+		mcp.WithDescription("Returns a list of all permission schemes. Full documentation: describe_jira_operation."),
 		mcp.WithString("expand", mcp.Description("")),
 	)
 
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,21 @@ func GetpreferenceHandler(cfg *config.APIConfig) func(ctx context.Context, reque
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["key"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("key=%v", val))
+			queryParams = append(queryParams, "key="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/mypreferences%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,9 +69,7 @@ func GetpreferenceHandler(cfg *config.APIConfig) func(ctx context.Context, reque
 
 func CreateGetpreferenceTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_mypreferences",
-		mcp.WithDescription("Returns preference of the currently logged in user. Preference key must be provided as input parameter (key). The
- value is returned exactly as it is. If key parameter is not provided or wrong - status code 404. If value is
- found  - status code 200."),
+		mcp.WithDescription("Returns preference of the currently logged in user. Preference key must be provided as input parameter (key). The value is returned exactly as it is. If key parameter is not provided or wrong - status code 404. If value is found - status code 200."),
 		mcp.WithString("key", mcp.Description("- key of the preference to be returned.")),
 	)
 
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,21 @@ func GetprogressbulkHandler(cfg *config.APIConfig) func(ctx context.Context, req
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["requestId"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("requestId=%v", val))
+			queryParams = append(queryParams, "requestId="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/reindex/request/bulk%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,8 +69,7 @@ func GetprogressbulkHandler(cfg *config.APIConfig) func(ctx context.Context, req
 
 func CreateGetprogressbulkTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_reindex_request_bulk",
-		mcp.WithDescription("Retrieves the progress of a multiple reindex requests.  Only reindex requests that actually exist will be returned
- in the results."),
+		mcp.WithDescription("Retrieves the progress of a multiple reindex requests. Only reindex requests that actually exist will be returned in the results."),
 		mcp.WithString("requestId", mcp.Description("the reindex request IDs.")),
 	)
 
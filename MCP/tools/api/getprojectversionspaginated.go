@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,30 +23,34 @@ func GetprojectversionspaginatedHandler(cfg *config.APIConfig) func(ctx context.
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["startAt"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("startAt=%v", val))
+			queryParams = append(queryParams, "startAt="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["maxResults"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("maxResults=%v", val))
+			queryParams = append(queryParams, "maxResults="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["orderBy"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("orderBy=%v", val))
+			queryParams = append(queryParams, "orderBy="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/project/%s/version%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		projectIdOrKey, err := request.RequireString("projectIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/%s/version%s", cfg.BaseURL, url.PathEscape(projectIdOrKey), queryString)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -76,16 +82,8 @@ func GetprojectversionspaginatedHandler(cfg *config.APIConfig) func(ctx context.
 
 func CreateGetprojectversionspaginatedTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_project_projectIdOrKey_version",
-		mcp.WithDescription("Returns all versions for the specified project. Results are <a href="#pagination">paginated</a>.
- <p>
- Results can be ordered by the following fields:
- <ul>
- <li>sequence</li>
- <li>name</li>
- <li>startDate</li>
- <li>releaseDate</li>
- </ul>
- </p>"),
+		mcp.WithDescription("Returns all versions for the specified project. Results are [paginated](#pagination). Full documentation: describe_jira_operation."),
+		mcp.WithString("projectIdOrKey", mcp.Required(), mcp.Description("the project id or project key")),
 		mcp.WithString("startAt", mcp.Description("the page offset, if not specified then defaults to 0")),
 		mcp.WithString("maxResults", mcp.Description("how many results on the page should be included. Defaults to 50.")),
 		mcp.WithString("orderBy", mcp.Description("ordering of the results.")),
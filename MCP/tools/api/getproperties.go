@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,30 +23,34 @@ func GetpropertiesHandler(cfg *config.APIConfig) func(ctx context.Context, reque
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["includeReservedKeys"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("includeReservedKeys=%v", val))
+			queryParams = append(queryParams, "includeReservedKeys="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["key"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("key=%v", val))
+			queryParams = append(queryParams, "key="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["workflowName"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("workflowName=%v", val))
+			queryParams = append(queryParams, "workflowName="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["workflowMode"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("workflowMode=%v", val))
+			queryParams = append(queryParams, "workflowMode="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/workflow/api/2/transitions/%s/properties%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/workflow/api/2/transitions/%s/properties%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -77,7 +83,8 @@ func GetpropertiesHandler(cfg *config.APIConfig) func(ctx context.Context, reque
 func CreateGetpropertiesTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_workflow_api_2_transitions_id_properties",
 		mcp.WithDescription("Return the property or properties associated with a transition."),
-		mcp.WithString("includeReservedKeys", mcp.Description("some keys under the \"jira.\" prefix are editable, some are not. Set this to true\n                            in order to include the non-editable keys in the response.")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the transitions id")),
+		mcp.WithString("includeReservedKeys", mcp.Description("some keys under the \"jira.\" prefix are editable, some are not. Set this to true in order to include the non-editable keys in the response.")),
 		mcp.WithString("key", mcp.Description("the name of the property key to query. Can be left off the query to return all properties.")),
 		mcp.WithString("workflowName", mcp.Description("the name of the workflow to use.")),
 		mcp.WithString("workflowMode", mcp.Description("the type of workflow to use. Can either be \"live\" or \"draft\".")),
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func GetpropertykeysHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/issuetype/%s/properties", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		issueTypeId, err := request.RequireString("issueTypeId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issuetype/%s/properties", cfg.BaseURL, url.PathEscape(issueTypeId))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func GetpropertykeysHandler(cfg *config.APIConfig) func(ctx context.Context, req
 func CreateGetpropertykeysTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_issuetype_issueTypeId_properties",
 		mcp.WithDescription("Returns the keys of all properties for the issue type identified by the id."),
+		mcp.WithString("issueTypeId", mcp.Required(), mcp.Description("the issue type from which the keys will be returned")),
 	)
 
 	return models.Tool{
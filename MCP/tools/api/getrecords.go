@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,39 +23,39 @@ func GetrecordsHandler(cfg *config.APIConfig) func(ctx context.Context, request
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["offset"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("offset=%v", val))
+			queryParams = append(queryParams, "offset="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["limit"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("limit=%v", val))
+			queryParams = append(queryParams, "limit="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["filter"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("filter=%v", val))
+			queryParams = append(queryParams, "filter="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["from"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("from=%v", val))
+			queryParams = append(queryParams, "from="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["to"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("to=%v", val))
+			queryParams = append(queryParams, "to="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["projectIds"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("projectIds=%v", val))
+			queryParams = append(queryParams, "projectIds="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["userIds"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("userIds=%v", val))
+			queryParams = append(queryParams, "userIds="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/auditing/record%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -89,8 +91,8 @@ func CreateGetrecordsTool(cfg *config.APIConfig) models.Tool {
 		mcp.WithString("offset", mcp.Description("- the number of record from which search starts")),
 		mcp.WithString("limit", mcp.Description("- maximum number of returned results (if is limit is <= 0 or > 1000, it will be set do default value: 1000)")),
 		mcp.WithString("filter", mcp.Description("- text query; each record that will be returned must contain the provided text in one of its fields")),
-		mcp.WithString("from", mcp.Description("- timestamp in past; 'from' must be less or equal 'to', otherwise the result set will be empty\n               only records that where created in the same moment or after the 'from' timestamp will be provided in response")),
-		mcp.WithString("to", mcp.Description("- timestamp in past; 'from' must be less or equal 'to', otherwise the result set will be empty\n               only records that where created in the same moment or earlier than the 'to' timestamp will be provided in response")),
+		mcp.WithString("from", mcp.Description("- timestamp in past; 'from' must be less or equal 'to', otherwise the result set will be empty only records that where created in the same moment or after the 'from' timestamp will be provided in response")),
+		mcp.WithString("to", mcp.Description("- timestamp in past; 'from' must be less or equal 'to', otherwise the result set will be empty only records that where created in the same moment or earlier than the 'to' timestamp will be provided in response")),
 		mcp.WithString("projectIds", mcp.Description("- list of project ids to look for")),
 		mcp.WithString("userIds", mcp.Description("- list of user ids to look for")),
 	)
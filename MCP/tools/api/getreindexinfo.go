@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,21 @@ func GetreindexinfoHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["taskId"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("taskId=%v", val))
+			queryParams = append(queryParams, "taskId="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/reindex%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,10 +69,8 @@ func GetreindexinfoHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 
 func CreateGetreindexinfoTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_reindex",
-		mcp.WithDescription("Returns information on the system reindexes.  If a reindex is currently taking place then information about this reindex is returned.
- If there is no active index task, then returns information about the latest reindex task run, otherwise returns a 404
- indicating that no reindex has taken place."),
-		mcp.WithString("taskId", mcp.Description("the id of an indexing task you wish to obtain details on.  If omitted, then defaults to the standard behaviour and\n               returns information on the active reindex task, or the last task to run if no reindex is taking place. .  If there is no\n               reindexing task with that id then a 404 is returned.")),
+		mcp.WithDescription("Returns information on the system reindexes. If a reindex is currently taking place then information about this reindex is returned. If there is no active index task, then returns information about the latest reindex task run, otherwise returns a 404 indicating that no reindex has taken place."),
+		mcp.WithString("taskId", mcp.Description("the id of an indexing task you wish to obtain details on. If omitted, then defaults to the standard behaviour and returns information on the active reindex task, or the last task to run if no reindex is taking place. . If there is no reindexing task with that id then a 404 is returned.")),
 	)
 
 	return models.Tool{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func GetremoteversionlinkHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/version/%s/remotelink/%s", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		versionId, err := request.RequireString("versionId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		globalId, err := request.RequireString("globalId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/version/%s/remotelink/%s", cfg.BaseURL, url.PathEscape(versionId), url.PathEscape(globalId))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +65,8 @@ func GetremoteversionlinkHandler(cfg *config.APIConfig) func(ctx context.Context
 func CreateGetremoteversionlinkTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_version_versionId_remotelink_globalId",
 		mcp.WithDescription("A REST sub-resource representing a remote version link"),
+		mcp.WithString("versionId", mcp.Required(), mcp.Description("the version id")),
+		mcp.WithString("globalId", mcp.Required(), mcp.Description("the global id")),
 	)
 
 	return models.Tool{
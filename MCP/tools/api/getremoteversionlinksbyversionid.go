@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func GetremoteversionlinksbyversionidHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/version/%s/remotelink", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		versionId, err := request.RequireString("versionId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/version/%s/remotelink", cfg.BaseURL, url.PathEscape(versionId))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func GetremoteversionlinksbyversionidHandler(cfg *config.APIConfig) func(ctx con
 func CreateGetremoteversionlinksbyversionidTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_version_versionId_remotelink",
 		mcp.WithDescription("Returns the remote version links associated with the given version ID."),
+		mcp.WithString("versionId", mcp.Required(), mcp.Description("the version id")),
 	)
 
 	return models.Tool{
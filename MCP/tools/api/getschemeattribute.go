@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func GetschemeattributeHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/permissionscheme/%s/attribute/%s", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		permissionSchemeId, err := request.RequireString("permissionSchemeId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		attributeKey, err := request.RequireString("attributeKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/permissionscheme/%s/attribute/%s", cfg.BaseURL, url.PathEscape(permissionSchemeId), url.PathEscape(attributeKey))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,7 +64,9 @@ func GetschemeattributeHandler(cfg *config.APIConfig) func(ctx context.Context,
 
 func CreateGetschemeattributeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_permissionscheme_permissionSchemeId_attribute_attributeKey",
-		mcp.WithDescription(""),
+		mcp.WithDescription("GET /api/2/permissionscheme/{permissionSchemeId}/attribute/{attributeKey}"),
+		mcp.WithString("permissionSchemeId", mcp.Required(), mcp.Description("permission scheme id")),
+		mcp.WithString("attributeKey", mcp.Required(), mcp.Description("permission scheme attribute key")),
 	)
 
 	return models.Tool{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func GetsecuritylevelsforprojectHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/project/%s/securitylevel", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		projectKeyOrId, err := request.RequireString("projectKeyOrId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/%s/securitylevel", cfg.BaseURL, url.PathEscape(projectKeyOrId))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,8 +60,8 @@ func GetsecuritylevelsforprojectHandler(cfg *config.APIConfig) func(ctx context.
 
 func CreateGetsecuritylevelsforprojectTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_project_projectKeyOrId_securitylevel",
-		mcp.WithDescription("Returns all security levels for the project that the current logged in user has access to.
- If the user does not have the Set Issue Security permission, the list will be empty."),
+		mcp.WithDescription("Returns all security levels for the project that the current logged in user has access to. If the user does not have the Set Issue Security permission, the list will be empty."),
+		mcp.WithString("projectKeyOrId", mcp.Required(), mcp.Description("- key or id of project to list the security levels for")),
 	)
 
 	return models.Tool{
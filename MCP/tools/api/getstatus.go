@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func GetstatusHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/status/%s", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		idOrName, err := request.RequireString("idOrName")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/status/%s", cfg.BaseURL, url.PathEscape(idOrName))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func GetstatusHandler(cfg *config.APIConfig) func(ctx context.Context, request m
 func CreateGetstatusTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_status_idOrName",
 		mcp.WithDescription("Returns a full representation of the Status having the given id or name."),
+		mcp.WithString("idOrName", mcp.Required(), mcp.Description("a numeric Status id or a status name")),
 	)
 
 	return models.Tool{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func GetstatuscategoryHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/statuscategory/%s", cfg.BaseURL)
-		req, err := http.NewRequest("GET", url, nil)
+		idOrKey, err := request.RequireString("idOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/statuscategory/%s", cfg.BaseURL, url.PathEscape(idOrKey))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func GetstatuscategoryHandler(cfg *config.APIConfig) func(ctx context.Context, r
 func CreateGetstatuscategoryTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_statuscategory_idOrKey",
 		mcp.WithDescription("Returns a full representation of the StatusCategory having the given id or key"),
+		mcp.WithString("idOrKey", mcp.Required(), mcp.Description("a numeric StatusCategory id or a status category key")),
 	)
 
 	return models.Tool{
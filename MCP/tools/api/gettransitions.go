@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func GettransitionsHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["transitionId"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("transitionId=%v", val))
+			queryParams = append(queryParams, "transitionId="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/issue/%s/transitions%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		issueIdOrKey, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issue/%s/transitions%s", cfg.BaseURL, url.PathEscape(issueIdOrKey), queryString)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,12 +73,8 @@ func GettransitionsHandler(cfg *config.APIConfig) func(ctx context.Context, requ
 
 func CreateGettransitionsTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_issue_issueIdOrKey_transitions",
-		mcp.WithDescription("Get a list of the transitions possible for this issue by the current user, along with fields that are required and their types.
- <p/>
- Fields will only be returned if <code>expand=transitions.fields</code>.
- <p/>
- The fields in the metadata correspond to the fields in the transition screen for that transition.
- Fields not in the screen will not be in the metadata."),
+		mcp.WithDescription("Get a list of the transitions possible for this issue by the current user, along with fields that are required and their types. Full documentation: describe_jira_operation."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("the issue id or key")),
 		mcp.WithString("transitionId", mcp.Description("")),
 	)
 
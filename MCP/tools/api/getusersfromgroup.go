@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,30 +23,30 @@ func GetusersfromgroupHandler(cfg *config.APIConfig) func(ctx context.Context, r
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["groupname"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("groupname=%v", val))
+			queryParams = append(queryParams, "groupname="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["includeInactiveUsers"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("includeInactiveUsers=%v", val))
+			queryParams = append(queryParams, "includeInactiveUsers="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["startAt"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("startAt=%v", val))
+			queryParams = append(queryParams, "startAt="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["maxResults"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("maxResults=%v", val))
+			queryParams = append(queryParams, "maxResults="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/group/member%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -76,8 +78,7 @@ func GetusersfromgroupHandler(cfg *config.APIConfig) func(ctx context.Context, r
 
 func CreateGetusersfromgroupTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_group_member",
-		mcp.WithDescription("This resource returns a <a href="#pagination">paginated</a> list of users who are members of the specified group and its subgroups.
- Users in the page are ordered by user names. User of this resource is required to have sysadmin or admin permissions."),
+		mcp.WithDescription("This resource returns a [paginated](#pagination) list of users who are members of the specified group and its subgroups. Users in the page are ordered by user names. User of this resource is required to have sysadmin or admin permissions."),
 		mcp.WithString("groupname", mcp.Description("a name of the group for which members will be returned.")),
 		mcp.WithString("includeInactiveUsers", mcp.Description("inactive users will be included in the response if set to true.")),
 		mcp.WithString("startAt", mcp.Description("the index of the first user in group to return (0 based).")),
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,28 @@ func GetworkflowHandler(cfg *config.APIConfig) func(ctx context.Context, request
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["workflowName"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("workflowName=%v", val))
+			queryParams = append(queryParams, "workflowName="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["returnDraftIfExists"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("returnDraftIfExists=%v", val))
+			queryParams = append(queryParams, "returnDraftIfExists="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/workflow%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/workflow%s", cfg.BaseURL, url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -71,8 +77,9 @@ func GetworkflowHandler(cfg *config.APIConfig) func(ctx context.Context, request
 func CreateGetworkflowTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_workflowscheme_id_workflow",
 		mcp.WithDescription("Returns the workflow mappings or requested mapping to the caller for the passed scheme."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the workflowscheme id")),
 		mcp.WithString("workflowName", mcp.Description("the workflow mapping to return. Null can be passed to return all mappings. Must be a valid workflow name.")),
-		mcp.WithString("returnDraftIfExists", mcp.Description("when true indicates that a scheme's draft, if it exists, should be queried instead of\n                            the scheme itself.")),
+		mcp.WithString("returnDraftIfExists", mcp.Description("when true indicates that a scheme's draft, if it exists, should be queried instead of the scheme itself.")),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,27 +23,27 @@ func ListHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.Ca
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["filter"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("filter=%v", val))
+			queryParams = append(queryParams, "filter="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["startAt"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("startAt=%v", val))
+			queryParams = append(queryParams, "startAt="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["maxResults"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("maxResults=%v", val))
+			queryParams = append(queryParams, "maxResults="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/dashboard%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -74,9 +76,9 @@ func ListHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.Ca
 func CreateListTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("get_api_2_dashboard",
 		mcp.WithDescription("Returns a list of all dashboards, optionally filtering them."),
-		mcp.WithString("filter", mcp.Description("an optional filter that is applied to the list of dashboards. Valid values include\n                        <code>\"favourite\"</code> for returning only favourite dashboards, and <code>\"my\"</code> for returning\n                        dashboards that are owned by the calling user.")),
-		mcp.WithString("startAt", mcp.Description("the index of the first dashboard to return (0-based). must be 0 or a multiple of\n                        <code>maxResults</code>")),
-		mcp.WithString("maxResults", mcp.Description("a hint as to the the maximum number of dashboards to return in each call. Note that the\n                        JIRA server reserves the right to impose a <code>maxResults</code> limit that is lower than the value that a\n                        client provides, dues to lack or resources or any other condition. When this happens, your results will be\n                        truncated. Callers should always check the returned <code>maxResults</code> to determine the value that is\n                        effectively being used.")),
+		mcp.WithString("filter", mcp.Description("an optional filter that is applied to the list of dashboards. Valid values include `\"favourite\"` for returning only favourite dashboards, and `\"my\"` for returning dashboards that are owned by the calling user.")),
+		mcp.WithString("startAt", mcp.Description("the index of the first dashboard to return (0-based). must be 0 or a multiple of `maxResults`")),
+		mcp.WithString("maxResults", mcp.Description("a hint as to the the maximum number of dashboards to return in each call.")),
 	)
 
 	return models.Tool{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func MergeHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/version/%s/mergeto/%s", cfg.BaseURL)
-		req, err := http.NewRequest("PUT", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		moveIssuesTo, err := request.RequireString("moveIssuesTo")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/version/%s/mergeto/%s", cfg.BaseURL, url.PathEscape(id), url.PathEscape(moveIssuesTo))
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +65,8 @@ func MergeHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.C
 func CreateMergeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_version_id_mergeto_moveIssuesTo",
 		mcp.WithDescription("Merge versions"),
+		mcp.WithString("id", mcp.Required(), mcp.Description("The version that will be merged to version `moveIssuesTo` and removed")),
+		mcp.WithString("moveIssuesTo", mcp.Required(), mcp.Description("The version to set fixVersion to on issues where the deleted version is the fix version, If null then the fixVersion is removed.")),
 	)
 
 	return models.Tool{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,27 @@ import (
 
 func MovefieldHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/screens/%s/tabs/%s/fields/%s/move", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		screenId, err := request.RequireString("screenId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tabId, err := request.RequireString("tabId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/screens/%s/tabs/%s/fields/%s/move", cfg.BaseURL, url.PathEscape(screenId), url.PathEscape(tabId), url.PathEscape(id))
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +69,9 @@ func MovefieldHandler(cfg *config.APIConfig) func(ctx context.Context, request m
 func CreateMovefieldTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_screens_screenId_tabs_tabId_fields_id_move",
 		mcp.WithDescription("Moves field on the given tab"),
+		mcp.WithString("screenId", mcp.Required(), mcp.Description("id of screen")),
+		mcp.WithString("tabId", mcp.Required(), mcp.Description("id of tab")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("")),
 	)
 
 	return models.Tool{
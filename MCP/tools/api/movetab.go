@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,27 @@ import (
 
 func MovetabHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/screens/%s/tabs/%s/move/%s", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		screenId, err := request.RequireString("screenId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tabId, err := request.RequireString("tabId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pos, err := request.RequireString("pos")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/screens/%s/tabs/%s/move/%s", cfg.BaseURL, url.PathEscape(screenId), url.PathEscape(tabId), url.PathEscape(pos))
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +69,9 @@ func MovetabHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp
 func CreateMovetabTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_screens_screenId_tabs_tabId_move_pos",
 		mcp.WithDescription("Moves tab position"),
+		mcp.WithString("screenId", mcp.Required(), mcp.Description("id of screen")),
+		mcp.WithString("tabId", mcp.Required(), mcp.Description("id of tab")),
+		mcp.WithString("pos", mcp.Required(), mcp.Description("position of tab")),
 	)
 
 	return models.Tool{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func MoveversionHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/version/%s/move", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/version/%s/move", cfg.BaseURL, url.PathEscape(id))
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,13 +60,8 @@ func MoveversionHandler(cfg *config.APIConfig) func(ctx context.Context, request
 
 func CreateMoveversionTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_version_id_move",
-		mcp.WithDescription("Modify a version's sequence within a project.
- <p/>
- The move version bean has 2 alternative field value pairs:
- <dl>
- <dt>position</dt><dd>An absolute position, which may have a value of 'First', 'Last', 'Earlier' or 'Later'</dd>
- <dt>after</dt><dd>A version to place this version after.  The value should be the self link of another version</dd>
- </dl>"),
+		mcp.WithDescription("Modify a version's sequence within a project. Full documentation: describe_jira_operation."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("a String containing the version id")),
 	)
 
 	return models.Tool{
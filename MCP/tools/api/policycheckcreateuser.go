@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,14 +16,14 @@ import (
 func PolicycheckcreateuserHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		url := fmt.Sprintf("%s/api/2/password/policy/createUser", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,16 +55,7 @@ func PolicycheckcreateuserHandler(cfg *config.APIConfig) func(ctx context.Contex
 
 func CreatePolicycheckcreateuserTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_password_policy_createUser",
-		mcp.WithDescription("Returns a list of statements explaining why the password policy would disallow a proposed password for a new user.
- <p>
- You can use this method to test the password policy validation. This could be done prior to an action 
- where a new user and related password are created, using methods like the ones in 
- <a href="https://docs.atlassian.com/jira/latest/com/atlassian/jira/bc/user/UserService.html">UserService</a>.      
- For example, you could use this to validate a password in a create user form in the user interface, as the user enters it.<br/>
- The username and new password must be not empty to perform the validation.<br/>
- Note, this method will help you validate against the policy only. It won't check any other validations that might be performed 
- when creating a new user, e.g. checking whether a user with the same name already exists.
- </p>"),
+		mcp.WithDescription("Returns a list of statements explaining why the password policy would disallow a proposed password for a new user. Full documentation: describe_jira_operation."),
 	)
 
 	return models.Tool{
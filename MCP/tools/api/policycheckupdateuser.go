@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,14 +16,14 @@ import (
 func PolicycheckupdateuserHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		url := fmt.Sprintf("%s/api/2/password/policy/updateUser", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,16 +55,7 @@ func PolicycheckupdateuserHandler(cfg *config.APIConfig) func(ctx context.Contex
 
 func CreatePolicycheckupdateuserTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_password_policy_updateUser",
-		mcp.WithDescription("Returns a list of statements explaining why the password policy would disallow a proposed new password for a user with an existing password.
- <p>
- You can use this method to test the password policy validation. This could be done prior to an action where the password 
- is actually updated, using methods like <a href="https://docs.atlassian.com/jira/latest/com/atlassian/jira/web/action/user/ChangePassword.html">ChangePassword</a>      
- or <a href="https://docs.atlassian.com/jira/latest/com/atlassian/jira/web/action/user/ResetPassword.html">ResetPassword</a>. 
- For example, you could use this to validate a password in a change password form in the user interface, as the user enters it.<br/>
- The user must exist and the username and new password must be not empty, to perform the validation.<br/>
- Note, this method will help you validate against the policy only. It won't check any other validations that might be performed 
- when submitting a password change/reset request, e.g. verifying whether the old password is valid.
- </p>"),
+		mcp.WithDescription("Returns a list of statements explaining why the password policy would disallow a proposed new password for a user with an existing password. Full documentation: describe_jira_operation."),
 	)
 
 	return models.Tool{
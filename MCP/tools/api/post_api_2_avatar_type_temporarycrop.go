@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func Post_api_2_avatar_type_temporarycropHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/avatar/%s/temporaryCrop", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		typeParam, err := request.RequireString("type")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/avatar/%s/temporaryCrop", cfg.BaseURL, url.PathEscape(typeParam))
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func Post_api_2_avatar_type_temporarycropHandler(cfg *config.APIConfig) func(ctx
 func CreatePost_api_2_avatar_type_temporarycropTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_avatar_type_temporaryCrop",
 		mcp.WithDescription("Updates the cropping instructions of the temporary avatar."),
+		mcp.WithString("type", mcp.Required(), mcp.Description("the avatar type")),
 	)
 
 	return models.Tool{
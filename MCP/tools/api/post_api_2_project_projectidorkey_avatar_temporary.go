@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func Post_api_2_project_projectidorkey_avatar_temporaryHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/project/%s/avatar/temporary", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		projectIdOrKey, err := request.RequireString("projectIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/%s/avatar/temporary", cfg.BaseURL, url.PathEscape(projectIdOrKey))
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,9 +60,8 @@ func Post_api_2_project_projectidorkey_avatar_temporaryHandler(cfg *config.APICo
 
 func CreatePost_api_2_project_projectidorkey_avatar_temporaryTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_project_projectIdOrKey_avatar_temporary",
-		mcp.WithDescription("Creates temporary avatar using multipart. The response is sent back as JSON stored in a textarea. This is because
- the client uses remote iframing to submit avatars using multipart. So we must send them a valid HTML page back from
- which the client parses the JSON."),
+		mcp.WithDescription("Creates temporary avatar using multipart. The response is sent back as JSON stored in a textarea. This is because the client uses remote iframing to submit avatars using multipart. So we must send them a valid HTML page back from which the client parses the JSON."),
+		mcp.WithString("projectIdOrKey", mcp.Required(), mcp.Description("Project id or project key")),
 	)
 
 	return models.Tool{
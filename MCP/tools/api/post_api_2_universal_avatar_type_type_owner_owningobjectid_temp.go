@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func Post_api_2_universal_avatar_type_type_owner_owningobjectid_tempHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/universal_avatar/type/%s/owner/%s/temp", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		typeParam, err := request.RequireString("type")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		owningObjectId, err := request.RequireString("owningObjectId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/universal_avatar/type/%s/owner/%s/temp", cfg.BaseURL, url.PathEscape(typeParam), url.PathEscape(owningObjectId))
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,7 +64,9 @@ func Post_api_2_universal_avatar_type_type_owner_owningobjectid_tempHandler(cfg
 
 func CreatePost_api_2_universal_avatar_type_type_owner_owningobjectid_tempTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_universal_avatar_type_type_owner_owningObjectId_temp",
-		mcp.WithDescription(""),
+		mcp.WithDescription("POST /api/2/universal_avatar/type/{type}/owner/{owningObjectId}/temp"),
+		mcp.WithString("type", mcp.Required(), mcp.Description("")),
+		mcp.WithString("owningObjectId", mcp.Required(), mcp.Description("")),
 	)
 
 	return models.Tool{
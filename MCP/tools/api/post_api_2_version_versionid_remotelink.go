@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func Post_api_2_version_versionid_remotelinkHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/version/%s/remotelink", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		versionId, err := request.RequireString("versionId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/version/%s/remotelink", cfg.BaseURL, url.PathEscape(versionId))
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,8 +60,8 @@ func Post_api_2_version_versionid_remotelinkHandler(cfg *config.APIConfig) func(
 
 func CreatePost_api_2_version_versionid_remotelinkTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_version_versionId_remotelink",
-		mcp.WithDescription("Create a remote version link via POST.  The link's global ID will be taken from the
- JSON payload if provided; otherwise, it will be generated."),
+		mcp.WithDescription("Create a remote version link via POST. The link's global ID will be taken from the JSON payload if provided; otherwise, it will be generated."),
+		mcp.WithString("versionId", mcp.Required(), mcp.Description("the version id")),
 	)
 
 	return models.Tool{
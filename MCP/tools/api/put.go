@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -18,8 +20,12 @@ func PutHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.Cal
 		if !ok {
 			return mcp.NewToolResultError("Invalid arguments object"), nil
 		}
-		url := fmt.Sprintf("%s/api/2/applicationrole/%s", cfg.BaseURL)
-		req, err := http.NewRequest("PUT", url, nil)
+		key, err := request.RequireString("key")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/applicationrole/%s", cfg.BaseURL, url.PathEscape(key))
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
@@ -29,7 +35,7 @@ func PutHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.Cal
 			req.Header.Set("If-Match", fmt.Sprintf("%v", val))
 		}
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -61,11 +67,8 @@ func PutHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.Cal
 
 func CreatePutTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_applicationrole_key",
-		mcp.WithDescription("Updates the ApplicationRole with the passed data. Only the groups and default groups setting of the
- role may be updated. Requests to change the key or the name of the role will be silently ignored.
- <p>
- Optional: If versionHash is passed through the If-Match header the request will be rejected if not the
- same as server"),
+		mcp.WithDescription("Updates the ApplicationRole with the passed data. Only the groups and default groups setting of the role may be updated. Requests to change the key or the name of the role will be silently ignored. Full documentation: describe_jira_operation."),
+		mcp.WithString("key", mcp.Required(), mcp.Description("the applicationrole key")),
 		mcp.WithString("If-Match", mcp.Description("the hash of the version to update. Optional Param")),
 	)
 
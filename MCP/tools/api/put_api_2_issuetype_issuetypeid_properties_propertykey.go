@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func Put_api_2_issuetype_issuetypeid_properties_propertykeyHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/issuetype/%s/properties/%s", cfg.BaseURL)
-		req, err := http.NewRequest("PUT", url, nil)
+		issueTypeId, err := request.RequireString("issueTypeId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		propertyKey, err := request.RequireString("propertyKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issuetype/%s/properties/%s", cfg.BaseURL, url.PathEscape(issueTypeId), url.PathEscape(propertyKey))
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,11 +64,9 @@ func Put_api_2_issuetype_issuetypeid_properties_propertykeyHandler(cfg *config.A
 
 func CreatePut_api_2_issuetype_issuetypeid_properties_propertykeyTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_issuetype_issueTypeId_properties_propertyKey",
-		mcp.WithDescription("Sets the value of the specified issue type's property.
- <p>
- You can use this resource to store a custom data against an issue type identified by the id. The user
- who stores the data is required to have permissions to edit an issue type.
- </p>"),
+		mcp.WithDescription("Sets the value of the specified issue type's property. Full documentation: describe_jira_operation."),
+		mcp.WithString("issueTypeId", mcp.Required(), mcp.Description("the issue type id")),
+		mcp.WithString("propertyKey", mcp.Required(), mcp.Description("the property key")),
 	)
 
 	return models.Tool{
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,14 +16,14 @@ import (
 func Put_api_2_myselfHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		url := fmt.Sprintf("%s/api/2/myself", cfg.BaseURL)
-		req, err := http.NewRequest("PUT", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,9 +55,7 @@ func Put_api_2_myselfHandler(cfg *config.APIConfig) func(ctx context.Context, re
 
 func CreatePut_api_2_myselfTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_myself",
-		mcp.WithDescription("Modify currently logged user. The "value" fields present will override the existing value.
- Fields skipped in request will not be changed. Only email and display name can be change that way.
- Requires user password."),
+		mcp.WithDescription("Modify currently logged user. The \"value\" fields present will override the existing value. Fields skipped in request will not be changed. Only email and display name can be change that way. Requires user password."),
 	)
 
 	return models.Tool{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func Put_api_2_project_projectidorkey_avatarHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/project/%s/avatar", cfg.BaseURL)
-		req, err := http.NewRequest("PUT", url, nil)
+		projectIdOrKey, err := request.RequireString("projectIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/%s/avatar", cfg.BaseURL, url.PathEscape(projectIdOrKey))
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,7 +60,8 @@ func Put_api_2_project_projectidorkey_avatarHandler(cfg *config.APIConfig) func(
 
 func CreatePut_api_2_project_projectidorkey_avatarTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_project_projectIdOrKey_avatar",
-		mcp.WithDescription(""),
+		mcp.WithDescription("PUT /api/2/project/{projectIdOrKey}/avatar"),
+		mcp.WithString("projectIdOrKey", mcp.Required(), mcp.Description("the project id or key")),
 	)
 
 	return models.Tool{
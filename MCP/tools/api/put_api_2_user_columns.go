@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,14 +16,14 @@ import (
 func Put_api_2_user_columnsHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		url := fmt.Sprintf("%s/api/2/user/columns", cfg.BaseURL)
-		req, err := http.NewRequest("PUT", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,8 +55,7 @@ func Put_api_2_user_columnsHandler(cfg *config.APIConfig) func(ctx context.Conte
 
 func CreatePut_api_2_user_columnsTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_user_columns",
-		mcp.WithDescription("Sets the default columns for the given user.  Admin permission will be required to get columns for a user
- other than the currently logged in user."),
+		mcp.WithDescription("Sets the default columns for the given user. Admin permission will be required to get columns for a user other than the currently logged in user."),
 	)
 
 	return models.Tool{
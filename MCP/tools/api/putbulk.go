@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -19,7 +20,7 @@ func PutbulkHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp
 			return mcp.NewToolResultError("Invalid arguments object"), nil
 		}
 		url := fmt.Sprintf("%s/api/2/applicationrole", cfg.BaseURL)
-		req, err := http.NewRequest("PUT", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
@@ -29,7 +30,7 @@ func PutbulkHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp
 			req.Header.Set("If-Match", fmt.Sprintf("%v", val))
 		}
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -61,10 +62,7 @@ func PutbulkHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp
 
 func CreatePutbulkTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_applicationrole",
-		mcp.WithDescription("Updates the ApplicationRoles with the passed data if the version hash is the same as the server.
- Only the groups and default groups setting of the role may be updated. Requests to change the key
- or the name of the role will be silently ignored. It is acceptable to pass only the roles that are updated
- as roles that are present in the server but not in data to update with, will not be deleted."),
+		mcp.WithDescription("Updates the ApplicationRoles with the passed data if the version hash is the same as the server. Full documentation: describe_jira_operation."),
 		mcp.WithString("If-Match", mcp.Description("")),
 	)
 
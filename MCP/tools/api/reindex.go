@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,30 +23,30 @@ func ReindexHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["type"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("type=%v", val))
+			queryParams = append(queryParams, "type="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["indexComments"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("indexComments=%v", val))
+			queryParams = append(queryParams, "indexComments="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["indexChangeHistory"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("indexChangeHistory=%v", val))
+			queryParams = append(queryParams, "indexChangeHistory="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["indexWorklogs"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("indexWorklogs=%v", val))
+			queryParams = append(queryParams, "indexWorklogs="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/reindex%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("POST", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -76,8 +78,8 @@ func ReindexHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp
 
 func CreateReindexTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_reindex",
-		mcp.WithDescription("Kicks off a reindex.  Need Admin permissions to perform this reindex."),
-		mcp.WithString("type", mcp.Description("Case insensitive String indicating type of reindex.  If omitted, then defaults to BACKGROUND_PREFERRED.")),
+		mcp.WithDescription("Kicks off a reindex. Need Admin permissions to perform this reindex."),
+		mcp.WithString("type", mcp.Description("Case insensitive String indicating type of reindex. If omitted, then defaults to BACKGROUND_PREFERRED.")),
 		mcp.WithString("indexComments", mcp.Description("Indicates that comments should also be reindexed. Not relevant for foreground reindex, where comments are always reindexed.")),
 		mcp.WithString("indexChangeHistory", mcp.Description("Indicates that changeHistory should also be reindexed. Not relevant for foreground reindex, where changeHistory is always reindexed.")),
 		mcp.WithString("indexWorklogs", mcp.Description("Indicates that changeHistory should also be reindexed. Not relevant for foreground reindex, where changeHistory is always reindexed.")),
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,30 +23,30 @@ func ReindexissuesHandler(cfg *config.APIConfig) func(ctx context.Context, reque
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["issueId"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("issueId=%v", val))
+			queryParams = append(queryParams, "issueId="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["indexComments"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("indexComments=%v", val))
+			queryParams = append(queryParams, "indexComments="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["indexChangeHistory"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("indexChangeHistory=%v", val))
+			queryParams = append(queryParams, "indexChangeHistory="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["indexWorklogs"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("indexWorklogs=%v", val))
+			queryParams = append(queryParams, "indexWorklogs="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/reindex/issue%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("POST", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -76,10 +78,7 @@ func ReindexissuesHandler(cfg *config.APIConfig) func(ctx context.Context, reque
 
 func CreateReindexissuesTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_reindex_issue",
-		mcp.WithDescription("Reindexes one or more individual issues.  Indexing is performed synchronously - the call returns when indexing of
- the issues has completed or a failure occurs.
- <p>
- Use either explicitly specified issue IDs or a JQL query to select issues to reindex."),
+		mcp.WithDescription("Reindexes one or more individual issues. Indexing is performed synchronously - the call returns when indexing of the issues has completed or a failure occurs. Full documentation: describe_jira_operation."),
 		mcp.WithString("issueId", mcp.Description("the IDs or keys of one or more issues to reindex.")),
 		mcp.WithString("indexComments", mcp.Description("Indicates that comments should also be reindexed.")),
 		mcp.WithString("indexChangeHistory", mcp.Description("Indicates that changeHistory should also be reindexed.")),
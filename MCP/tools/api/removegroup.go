@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,24 @@ func RemovegroupHandler(cfg *config.APIConfig) func(ctx context.Context, request
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["groupname"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("groupname=%v", val))
+			queryParams = append(queryParams, "groupname="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["swapGroup"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("swapGroup=%v", val))
+			queryParams = append(queryParams, "swapGroup="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/group%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -70,11 +72,9 @@ func RemovegroupHandler(cfg *config.APIConfig) func(ctx context.Context, request
 
 func CreateRemovegroupTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_group",
-		mcp.WithDescription("Deletes a group by given group parameter.
- <p>
- Returns no content"),
+		mcp.WithDescription("Deletes a group by given group parameter. Full documentation: describe_jira_operation."),
 		mcp.WithString("groupname", mcp.Description("(mandatory) The name of the group to delete.")),
-		mcp.WithString("swapGroup", mcp.Description("If you delete a group and content is restricted to that group, the content will be hidden from all users. \n To prevent this, use this parameter to specify a different group to transfer the restrictions (comments and worklogs only) to.")),
+		mcp.WithString("swapGroup", mcp.Description("If you delete a group and content is restricted to that group, the content will be hidden from all users. To prevent this, use this parameter to specify a different group to transfer the restrictions (comments and worklogs only) to.")),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,24 @@ func RemoveuserfromgroupHandler(cfg *config.APIConfig) func(ctx context.Context,
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["groupname"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("groupname=%v", val))
+			queryParams = append(queryParams, "groupname="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["username"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("username=%v", val))
+			queryParams = append(queryParams, "username="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
 		url := fmt.Sprintf("%s/api/2/group/user%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("DELETE", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -70,9 +72,7 @@ func RemoveuserfromgroupHandler(cfg *config.APIConfig) func(ctx context.Context,
 
 func CreateRemoveuserfromgroupTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("delete_api_2_group_user",
-		mcp.WithDescription("Removes given user from a group.
- <p>
- Returns no content"),
+		mcp.WithDescription("Removes given user from a group. Full documentation: describe_jira_operation."),
 		mcp.WithString("groupname", mcp.Description("A name of requested group.")),
 		mcp.WithString("username", mcp.Description("User to remove from a group")),
 	)
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func SetdraftissuetypeHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/draft/issuetype/%s", cfg.BaseURL)
-		req, err := http.NewRequest("PUT", url, nil)
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		issueType, err := request.RequireString("issueType")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/workflowscheme/%s/draft/issuetype/%s", cfg.BaseURL, url.PathEscape(id), url.PathEscape(issueType))
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,10 +64,9 @@ func SetdraftissuetypeHandler(cfg *config.APIConfig) func(ctx context.Context, r
 
 func CreateSetdraftissuetypeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_workflowscheme_id_draft_issuetype_issueType",
-		mcp.WithDescription("Set the issue type mapping for the passed draft scheme.
- <p/>
- The passed representation can have its updateDraftIfNeeded flag set to true to indicate that
- the draft should be created/updated when the actual scheme cannot be edited."),
+		mcp.WithDescription("Set the issue type mapping for the passed draft scheme. Full documentation: describe_jira_operation."),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the workflowscheme id")),
+		mcp.WithString("issueType", mcp.Required(), mcp.Description("the issue type")),
 	)
 
 	return models.Tool{
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func SetschemeattributeHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/permissionscheme/%s/attribute/%s", cfg.BaseURL)
-		req, err := http.NewRequest("PUT", url, nil)
+		permissionSchemeId, err := request.RequireString("permissionSchemeId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		key, err := request.RequireString("key")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/permissionscheme/%s/attribute/%s", cfg.BaseURL, url.PathEscape(permissionSchemeId), url.PathEscape(key))
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,8 +64,9 @@ func SetschemeattributeHandler(cfg *config.APIConfig) func(ctx context.Context,
 
 func CreateSetschemeattributeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_permissionscheme_permissionSchemeId_attribute_key",
-		mcp.WithDescription("Updates or inserts the attribute for a permission scheme specified by permission scheme id.
- The attribute consists of the key and the value. The value will be converted to Boolean using Boolean#valueOf."),
+		mcp.WithDescription("Updates or inserts the attribute for a permission scheme specified by permission scheme id. The attribute consists of the key and the value. The value will be converted to Boolean using Boolean#valueOf."),
+		mcp.WithString("permissionSchemeId", mcp.Required(), mcp.Description("permission scheme id")),
+		mcp.WithString("key", mcp.Required(), mcp.Description("permission scheme attribute key")),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,28 @@ func StoretemporaryavatarHandler(cfg *config.APIConfig) func(ctx context.Context
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["filename"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("filename=%v", val))
+			queryParams = append(queryParams, "filename="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["size"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("size=%v", val))
+			queryParams = append(queryParams, "size="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/avatar/%s/temporary%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("POST", url, nil)
+		typeParam, err := request.RequireString("type")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/avatar/%s/temporary%s", cfg.BaseURL, url.PathEscape(typeParam), queryString)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -71,6 +77,7 @@ func StoretemporaryavatarHandler(cfg *config.APIConfig) func(ctx context.Context
 func CreateStoretemporaryavatarTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_api_2_avatar_type_temporary",
 		mcp.WithDescription("Creates temporary avatar"),
+		mcp.WithString("type", mcp.Required(), mcp.Description("the avatar type")),
 		mcp.WithString("filename", mcp.Description("name of file being uploaded")),
 		mcp.WithString("size", mcp.Description("size of file")),
 	)
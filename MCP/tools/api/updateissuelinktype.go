@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,19 @@ import (
 
 func UpdateissuelinktypeHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/issueLinkType/%s", cfg.BaseURL)
-		req, err := http.NewRequest("PUT", url, nil)
+		issueLinkTypeId, err := request.RequireString("issueLinkTypeId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issueLinkType/%s", cfg.BaseURL, url.PathEscape(issueLinkTypeId))
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +61,7 @@ func UpdateissuelinktypeHandler(cfg *config.APIConfig) func(ctx context.Context,
 func CreateUpdateissuelinktypeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_issueLinkType_issueLinkTypeId",
 		mcp.WithDescription("Update the specified issue link type."),
+		mcp.WithString("issueLinkTypeId", mcp.Required(), mcp.Description("the issue link type id")),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,21 +23,25 @@ func UpdatepermissionschemeHandler(cfg *config.APIConfig) func(ctx context.Conte
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["expand"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("expand=%v", val))
+			queryParams = append(queryParams, "expand="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/permissionscheme/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("PUT", url, nil)
+		schemeId, err := request.RequireString("schemeId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/permissionscheme/%s%s", cfg.BaseURL, url.PathEscape(schemeId), queryString)
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -67,17 +73,8 @@ func UpdatepermissionschemeHandler(cfg *config.APIConfig) func(ctx context.Conte
 
 func CreateUpdatepermissionschemeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_permissionscheme_schemeId",
-		mcp.WithDescription("Updates a permission scheme.
- <p>
- If the permissions list is present then it will be set in the permission scheme, which basically means it will overwrite any permission grants that
- existed in the permission scheme. Sending an empty list will remove all permission grants from the permission scheme.
- </p>
- <p>
- To update just the name and description, do not send permissions list at all.
- </p>
- <p>
- To add or remove a single permission grant instead of updating the whole list at once use the <b>{schemeId}/permission/</b> resource.
- </p>"),
+		mcp.WithDescription("Updates a permission scheme. Full documentation: describe_jira_operation."),
+		mcp.WithString("schemeId", mcp.Required(), mcp.Description("the scheme id")),
 		mcp.WithString("expand", mcp.Description("")),
 	)
 
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,15 +16,23 @@ import (
 
 func UpdateprojecttypeHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		url := fmt.Sprintf("%s/api/2/project/%s/type/%s", cfg.BaseURL)
-		req, err := http.NewRequest("PUT", url, nil)
+		projectIdOrKey, err := request.RequireString("projectIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		newProjectTypeKey, err := request.RequireString("newProjectTypeKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/project/%s/type/%s", cfg.BaseURL, url.PathEscape(projectIdOrKey), url.PathEscape(newProjectTypeKey))
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -55,6 +65,8 @@ func UpdateprojecttypeHandler(cfg *config.APIConfig) func(ctx context.Context, r
 func CreateUpdateprojecttypeTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_project_projectIdOrKey_type_newProjectTypeKey",
 		mcp.WithDescription("Updates the type of a project."),
+		mcp.WithString("projectIdOrKey", mcp.Required(), mcp.Description("identity of the project to update")),
+		mcp.WithString("newProjectTypeKey", mcp.Required(), mcp.Description("The key of the new project type")),
 	)
 
 	return models.Tool{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -21,24 +23,32 @@ func UpdateworklogHandler(cfg *config.APIConfig) func(ctx context.Context, reque
 		}
 		queryParams := make([]string, 0)
 		if val, ok := args["adjustEstimate"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("adjustEstimate=%v", val))
+			queryParams = append(queryParams, "adjustEstimate="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		if val, ok := args["newEstimate"]; ok {
-			queryParams = append(queryParams, fmt.Sprintf("newEstimate=%v", val))
+			queryParams = append(queryParams, "newEstimate="+url.QueryEscape(fmt.Sprint(val)))
 		}
 		queryString := ""
 		if len(queryParams) > 0 {
 			queryString = "?" + strings.Join(queryParams, "&")
 		}
-		url := fmt.Sprintf("%s/api/2/issue/%s/worklog/%s%s", cfg.BaseURL, queryString)
-		req, err := http.NewRequest("PUT", url, nil)
+		issueIdOrKey, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		id, err := request.RequireString("id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		url := fmt.Sprintf("%s/api/2/issue/%s/worklog/%s%s", cfg.BaseURL, url.PathEscape(issueIdOrKey), url.PathEscape(id), queryString)
+		req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -70,15 +80,10 @@ func UpdateworklogHandler(cfg *config.APIConfig) func(ctx context.Context, reque
 
 func CreateUpdateworklogTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("put_api_2_issue_issueIdOrKey_worklog_id",
-		mcp.WithDescription("Updates an existing worklog entry.
- <p>Note that:</p>
-  <ul>
-      <li>Fields possible for editing are: comment, visibility, started, timeSpent and timeSpentSeconds.</li>
-      <li>Either timeSpent or timeSpentSeconds can be set.</li>
-      <li>Fields which are not set will not be updated.</li>
-      <li>For a request to be valid, it has to have at least one field change.</li>
-  </ul>"),
-		mcp.WithString("adjustEstimate", mcp.Description("(optional) allows you to provide specific instructions to update the remaining time estimate of the issue.  Valid values are\n                       <ul>\n                       <li>\"new\" - sets the estimate to a specific value</li>\n                       <li>\"leave\"- leaves the estimate as is</li>\n                       <li>\"auto\"- Default option.  Will automatically adjust the value based on the new timeSpent specified on the worklog</li> </ul>")),
+		mcp.WithDescription("Updates an existing worklog entry. Full documentation: describe_jira_operation."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("the issue id or key")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("the worklog id")),
+		mcp.WithString("adjustEstimate", mcp.Description("(optional) allows you to provide specific instructions to update the remaining time estimate of the issue.")),
 		mcp.WithString("newEstimate", mcp.Description("(required when \"new\" is selected for adjustEstimate) the new value for the remaining estimate field.")),
 	)
 
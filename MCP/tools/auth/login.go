@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/jira-7-6-1/mcp-server/client"
 	"github.com/jira-7-6-1/mcp-server/config"
 	"github.com/jira-7-6-1/mcp-server/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,14 +16,14 @@ import (
 func LoginHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		url := fmt.Sprintf("%s/auth/1/session", cfg.BaseURL)
-		req, err := http.NewRequest("POST", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Failed to create request", err), nil
 		}
 		// No authentication required for this endpoint
 		req.Header.Set("Accept", "application/json")
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := client.Do(req)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("Request failed", err), nil
 		}
@@ -54,11 +55,7 @@ func LoginHandler(cfg *config.APIConfig) func(ctx context.Context, request mcp.C
 
 func CreateLoginTool(cfg *config.APIConfig) models.Tool {
 	tool := mcp.NewTool("post_auth_1_session",
-		mcp.WithDescription("Creates a new session for a user in JIRA. Once a session has been successfully created it can be used to access
- any of JIRA's remote APIs and also the web UI by passing the appropriate HTTP Cookie header.
- <p>
- Note that it is generally preferrable to use HTTP BASIC authentication with the REST API. However, this resource
- may be used to mimic the behaviour of JIRA's log-in page (e.g. to display log-in errors to a user)."),
+		mcp.WithDescription("Creates a new session for a user in JIRA. Once a session has been successfully created it can be used to access any of JIRA's remote APIs and also the web UI by passing the appropriate HTTP Cookie header. Full documentation: describe_jira_operation."),
 	)
 
 	return models.Tool{
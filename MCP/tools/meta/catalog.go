@@ -0,0 +1,136 @@
+package tools
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/jira-7-6-1/mcp-server/operations"
+)
+
+// Catalog indexes the generated Jira tools so the meta-tools can search,
+// describe and invoke them without registering each one with the client.
+type Catalog struct {
+	tools  []models.Tool
+	byName map[string]models.Tool
+}
+
+// NewCatalog builds a catalog over tools.
+func NewCatalog(tools []models.Tool) *Catalog {
+	c := &Catalog{tools: tools, byName: make(map[string]models.Tool, len(tools))}
+	for _, tool := range tools {
+		c.byName[tool.Definition.Name] = tool
+	}
+	return c
+}
+
+// Lookup returns the tool registered under name. Deprecated generated names
+// and swagger operationIds are accepted as well.
+func (c *Catalog) Lookup(name string) (models.Tool, bool) {
+	if tool, ok := c.byName[name]; ok {
+		return tool, true
+	}
+	if op, ok := operations.Resolve(name); ok {
+		tool, ok := c.byName[op.Name]
+		return tool, ok
+	}
+	return models.Tool{}, false
+}
+
+// Match is a search hit returned by Search.
+type Match struct {
+	Name    string `json:"name"`
+	Summary string `json:"summary"`
+	score   int
+}
+
+// Search ranks tools by how well their name and description match the
+// keywords in query. Name hits weigh more than description hits.
+func (c *Catalog) Search(query string, limit int) []Match {
+	terms := words(query)
+	if len(terms) == 0 {
+		return nil
+	}
+	var matches []Match
+	for _, tool := range c.tools {
+		nameWords := words(tool.Definition.Name)
+		if op, ok := operations.ByName(tool.Definition.Name); ok {
+			nameWords = append(nameWords, words(op.OperationID)...)
+		}
+		descWords := words(tool.Definition.Description)
+		score := 0
+		for _, term := range terms {
+			score += 3 * count(nameWords, term)
+			score += min(count(descWords, term), 3)
+		}
+		if score > 0 {
+			matches = append(matches, Match{Name: tool.Definition.Name, Summary: summary(tool.Definition.Description), score: score})
+		}
+	}
+	// Prefer higher scores, then the more general (shorter) operation
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return len(matches[i].Name) < len(matches[j].Name)
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// words splits text into lowercase, singular keywords, breaking tool names on
+// underscores and camelCase boundaries.
+func words(text string) []string {
+	var out []string
+	var current []rune
+	flush := func() {
+		if len(current) > 1 {
+			w := strings.ToLower(string(current))
+			if len(w) > 3 && strings.HasSuffix(w, "s") {
+				w = strings.TrimSuffix(w, "s")
+			}
+			out = append(out, w)
+		}
+		current = current[:0]
+	}
+	var prev rune
+	for _, r := range text {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && unicode.IsLower(prev):
+			flush()
+			current = append(current, r)
+		default:
+			current = append(current, r)
+		}
+		prev = r
+	}
+	flush()
+	return out
+}
+
+func count(haystack []string, term string) int {
+	n := 0
+	for _, w := range haystack {
+		if w == term {
+			n++
+		}
+	}
+	return n
+}
+
+// summary returns the first sentence of a description.
+func summary(description string) string {
+	description = strings.Join(strings.Fields(description), " ")
+	if i := strings.Index(description, ". "); i >= 0 {
+		description = description[:i+1]
+	}
+	if len(description) > 200 {
+		description = description[:197] + "..."
+	}
+	return description
+}
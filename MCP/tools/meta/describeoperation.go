@@ -0,0 +1,60 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jira-7-6-1/mcp-server/docs"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/jira-7-6-1/mcp-server/operations"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func DescribeoperationHandler(catalog *Catalog) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		name, err := request.RequireString("operationId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tool, ok := catalog.Lookup(name)
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("Unknown operation %q. Use search_jira_operations to find one.", name)), nil
+		}
+
+		description := map[string]any{"tool": tool.Definition}
+		if op, ok := operations.Resolve(tool.Definition.Name); ok {
+			if doc, ok := docs.Lookup(op.Name); ok {
+				description["documentation"] = doc
+			}
+			description["operationId"] = op.OperationID
+			description["method"] = op.Method
+			description["path"] = op.Path
+			description["deprecatedAlias"] = op.Legacy
+		}
+
+		prettyJSON, err := json.MarshalIndent(description, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+		}
+
+		return mcp.NewToolResultText(string(prettyJSON)), nil
+	}
+}
+
+func CreateDescribeoperationTool(catalog *Catalog) models.Tool {
+	tool := mcp.NewTool("describe_jira_operation",
+		mcp.WithDescription("Returns the full Markdown documentation and input schema of a Jira tool or REST operation."),
+		mcp.WithTitleAnnotation("Describe Jira operation"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+		mcp.WithString("operationId", mcp.Required(), mcp.Description("the tool name, e.g. from search_jira_operations; deprecated names and swagger operationIds are accepted too")),
+	)
+
+	return models.Tool{
+		Definition: tool,
+		Handler:    DescribeoperationHandler(catalog),
+	}
+}
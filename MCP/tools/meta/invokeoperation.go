@@ -0,0 +1,49 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func InvokeoperationHandler(catalog *Catalog) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		name, err := request.RequireString("operationId")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tool, ok := catalog.Lookup(name)
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("Unknown operation %q. Use search_jira_operations to find one.", name)), nil
+		}
+		args, _ := request.GetArguments()["arguments"].(map[string]any)
+		if args == nil {
+			args = map[string]any{}
+		}
+
+		name = tool.Definition.Name
+		inner := mcp.CallToolRequest{}
+		inner.Params.Name = name
+		inner.Params.Arguments = args
+		inner.Params.Meta = request.Params.Meta // Keep the progress token
+		logging.FromContext(ctx).Debug("invoking jira operation", "operation", name)
+		return tool.Handler(logging.WithToolName(ctx, name), inner)
+	}
+}
+
+func CreateInvokeoperationTool(catalog *Catalog) models.Tool {
+	tool := mcp.NewTool("invoke_jira_operation",
+		mcp.WithDescription("Calls a Jira REST operation by name with the given arguments. Check the expected arguments with describe_jira_operation first."),
+		mcp.WithTitleAnnotation("Invoke Jira operation"),
+		mcp.WithString("operationId", mcp.Required(), mcp.Description("the operation name returned by search_jira_operations; swagger operationIds are accepted too")),
+		mcp.WithObject("arguments", mcp.Description("arguments for the operation, matching its input schema")),
+	)
+
+	return models.Tool{
+		Definition: tool,
+		Handler:    InvokeoperationHandler(catalog),
+	}
+}
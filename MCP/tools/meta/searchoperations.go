@@ -0,0 +1,47 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func SearchoperationsHandler(catalog *Catalog) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		query, err := request.RequireString("query")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		matches := catalog.Search(query, request.GetInt("limit", 10))
+		if len(matches) == 0 {
+			return mcp.NewToolResultText("No Jira operations matched the query. Try broader keywords such as \"issue\", \"project\" or \"user\"."), nil
+		}
+
+		prettyJSON, err := json.MarshalIndent(matches, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+		}
+
+		return mcp.NewToolResultText(string(prettyJSON)), nil
+	}
+}
+
+func CreateSearchoperationsTool(catalog *Catalog) models.Tool {
+	tool := mcp.NewTool("search_jira_operations",
+		mcp.WithDescription("Searches the available Jira REST operations by keyword over operation names and descriptions. Use describe_jira_operation to get the parameters of a match and invoke_jira_operation to call it."),
+		mcp.WithTitleAnnotation("Search Jira operations"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+		mcp.WithString("query", mcp.Required(), mcp.Description("keywords describing what you want to do, e.g. \"add comment to issue\"")),
+		mcp.WithNumber("limit", mcp.Description("maximum number of operations to return (defaults to 10)")),
+	)
+
+	return models.Tool{
+		Definition: tool,
+		Handler:    SearchoperationsHandler(catalog),
+	}
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func transitionIssueTool(cfg *config.APIConfig) models.Tool {
+	tool := mcp.NewTool("jira_transition_issue",
+		mcp.WithDescription("Moves an issue through its workflow by target status or transition name, without looking up transition ids. Fields the transition screen requires, such as Resolution, are reported before anything is sent and can be given by display name together with a comment. With maxHops, a status several transitions away is reached along the shortest path; if a hop fails, the result says where the issue stopped and how to move it back."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("key or id of the issue, e.g. ABC-123")),
+		mcp.WithString("status", mcp.Description("name of the status to move the issue to, e.g. In Progress")),
+		mcp.WithString("transition", mcp.Description("name or id of the transition to perform, e.g. Resolve Issue; use instead of status when several transitions lead to it")),
+		mcp.WithObject("fields", mcp.Description("fields of the transition screen by display name or id, e.g. {\"Resolution\": \"Fixed\", \"Fix Version/s\": \"2.0\"}")),
+		mcp.WithString("comment", mcp.Description("comment to add with the transition, in Markdown unless markup is wiki")),
+		withMarkup(),
+		mcp.WithNumber("maxHops", mcp.Description("how many transitions may be chained to reach status when none leads there directly, e.g. 3 for Open → In Progress → Resolved → Closed (default 1). Each hop takes the fields on its screen; the comment goes with the last one")),
+	)
+	return models.Tool{Definition: tool, Handler: transitionIssueHandler(cfg)}
+}
+
+// issueTransition is a transition available to an issue, with the fields of
+// its screen.
+type issueTransition struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	To   struct {
+		Name string `json:"name"`
+	} `json:"to"`
+	Fields map[string]metaField `json:"fields"`
+}
+
+func (t issueTransition) String() string {
+	return fmt.Sprintf("%s → %s", t.Name, t.To.Name)
+}
+
+func transitionIssueHandler(cfg *config.APIConfig) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		key, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		status := request.GetString("status", "")
+		name := request.GetString("transition", "")
+		if status == "" && name == "" {
+			return mcp.NewToolResultError("Give the target status or the transition to perform."), nil
+		}
+		input, _ := request.GetArguments()["fields"].(map[string]any)
+		comment := request.GetString("comment", "")
+		if comment != "" {
+			comment = toWiki(request, comment)
+		}
+
+		issue, err := issueFields(ctx, cfg, key, []string{"status", "project", "issuetype"})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to get the issue", err), nil
+		}
+		current := fieldName(issue["status"])
+		transitions, err := issueTransitions(ctx, cfg, key)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to get transitions", err), nil
+		}
+		t, err := pickTransition(transitions, current, status, name)
+		if err != nil && name == "" && !strings.EqualFold(current, status) {
+			graph := learnWorkflow(ctx, cfg, current, transitions, fieldName(issue["project"]), fieldName(issue["issuetype"]))
+			path := graph.shortestPath(current, status)
+			maxHops := int(request.GetFloat("maxHops", 1))
+			switch {
+			case path == nil:
+				err = fmt.Errorf("%w, and no path to it was found in the status changes of other %s issues in %s", err, fieldName(issue["issuetype"]), fieldName(issue["project"]))
+			case len(path) > maxHops:
+				err = fmt.Errorf("%s is %d transitions away: %s → %s; set maxHops to %d to go there", status, len(path), current, strings.Join(path, " → "), len(path))
+			default:
+				return multiHopResult(ctx, cfg, key, current, path, input, comment)
+			}
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Nothing was changed: %s", err)), nil
+		}
+
+		fields, problems := transitionFields(ctx, cfg, t, input)
+		if len(problems) > 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("Nothing was changed, %s needs:\n- %s", t, strings.Join(problems, "\n- "))), nil
+		}
+		if err := doTransition(ctx, cfg, key, t, fields, comment); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		logging.FromContext(ctx).Info("issue transitioned", "issue", key, "transition", t.Name, "from", current, "to", t.To.Name)
+
+		out := map[string]any{"issue": key, "transition": t.Name, "from": current, "to": t.To.Name}
+		pretty, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+		}
+		return mcp.NewToolResultText(string(pretty)), nil
+	}
+}
+
+// multiHopResult walks path and reports each hop. A partial move is an
+// error result that says where the issue stopped.
+func multiHopResult(ctx context.Context, cfg *config.APIConfig, key, current string, path []string, input map[string]any, comment string) (*mcp.CallToolResult, error) {
+	report, ok := walkPath(ctx, cfg, key, current, path, input, comment)
+	pretty, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+	}
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("Stopped in %s before reaching %s:\n%s", report.Status, report.Target, pretty)), nil
+	}
+	return mcp.NewToolResultText(string(pretty)), nil
+}
+
+// fieldName returns the name of a field value such as a status or project
+// (its key for projects).
+func fieldName(v any) string {
+	m, _ := v.(map[string]any)
+	for _, key := range []string{"key", "name"} {
+		if s, ok := m[key].(string); ok {
+			return s
+		}
+	}
+	return ""
+}
+
+// issueTransitions returns the transitions available to an issue in its
+// current status.
+func issueTransitions(ctx context.Context, cfg *config.APIConfig, key string) ([]issueTransition, error) {
+	var result struct {
+		Transitions []issueTransition `json:"transitions"`
+	}
+	path := "/api/2/issue/" + url.PathEscape(key) + "/transitions?expand=transitions.fields"
+	if err := getJSON(ctx, cfg, path, &result); err != nil {
+		return nil, err
+	}
+	return result.Transitions, nil
+}
+
+// pickTransition finds the transition called name, or else the first one
+// leading to status. The error explains what is available instead.
+func pickTransition(transitions []issueTransition, current, status, name string) (issueTransition, error) {
+	for _, t := range transitions {
+		if name != "" && (strings.EqualFold(t.Name, name) || t.ID == name) {
+			if status != "" && !strings.EqualFold(t.To.Name, status) {
+				return t, fmt.Errorf("transition %s does not lead to %s", t, status)
+			}
+			return t, nil
+		}
+		if name == "" && strings.EqualFold(t.To.Name, status) {
+			return t, nil
+		}
+	}
+	if name == "" && strings.EqualFold(current, status) {
+		return issueTransition{}, fmt.Errorf("the issue is already in %s", current)
+	}
+	available := make([]string, 0, len(transitions))
+	for _, t := range transitions {
+		available = append(available, t.String())
+	}
+	what := fmt.Sprintf("no transition leads from %s to %s", current, status)
+	if name != "" {
+		what = fmt.Sprintf("transition %q is not available in %s", name, current)
+	}
+	if len(available) == 0 {
+		return issueTransition{}, fmt.Errorf("%s; the issue has no transitions you can perform", what)
+	}
+	return issueTransition{}, fmt.Errorf("%s; available: %s", what, strings.Join(available, ", "))
+}
+
+// transitionFields resolves the fields given for the screen of t and checks
+// that the required ones are there.
+func transitionFields(ctx context.Context, cfg *config.APIConfig, t issueTransition, input map[string]any) (map[string]any, []string) {
+	resolver := newFieldResolver(ctx, cfg, t.Fields, fmt.Sprintf("screen of transition %s", t.Name))
+	fields, problems := resolver.resolveAll(input)
+	return fields, append(problems, resolver.missingRequired(fields)...)
+}
+
+// doTransition performs t on an issue with the given screen fields and
+// optional comment.
+func doTransition(ctx context.Context, cfg *config.APIConfig, key string, t issueTransition, fields map[string]any, comment string) error {
+	body := map[string]any{"transition": map[string]any{"id": t.ID}}
+	if len(fields) > 0 {
+		body["fields"] = fields
+	}
+	if comment != "" {
+		body["update"] = map[string]any{"comment": []any{map[string]any{"add": map[string]any{"body": comment}}}}
+	}
+	_, err := jiraRequest(ctx, cfg, "POST", "/api/2/issue/"+url.PathEscape(key)+"/transitions", body)
+	return err
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/jira-7-6-1/mcp-server/client"
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// updateVerbs are the edit operations jira_update_issue accepts, each as a
+// parameter mapping field names to values.
+var updateVerbs = []string{"set", "add", "remove"}
+
+func updateIssueTool(cfg *config.APIConfig) models.Tool {
+	tool := mcp.NewTool("jira_update_issue",
+		mcp.WithDescription("Edits an issue with fields given by display name. set replaces a value, with the description and other multi-line texts in Markdown; add and remove change multi-value fields such as Labels, Component/s, Fix Version/s and multi-selects. Fields are checked against the edit screen of the issue before anything is sent, and the result lists the old and new value of each changed field."),
+		mcp.WithString("issueIdOrKey", mcp.Required(), mcp.Description("key or id of the issue, e.g. ABC-123")),
+		mcp.WithObject("set", mcp.Description("fields to replace, by display name or id, e.g. {\"Priority\": \"High\", \"Story Points\": 5}; null clears a field")),
+		mcp.WithObject("add", mcp.Description("values to add to multi-value fields, e.g. {\"Labels\": [\"backend\"], \"Fix Version/s\": \"2.0\"}")),
+		mcp.WithObject("remove", mcp.Description("values to remove from multi-value fields, e.g. {\"Component/s\": \"UI\"}")),
+		mcp.WithBoolean("notifyUsers", mcp.Description("email watchers about the change (default true; false needs administrator or project administrator rights)")),
+		withMarkup(),
+	)
+	return models.Tool{Definition: tool, Handler: updateIssueHandler(cfg)}
+}
+
+// fieldChange is one line of the diff returned by jira_update_issue.
+type fieldChange struct {
+	Field string `json:"field"`
+	ID    string `json:"id"`
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+func updateIssueHandler(cfg *config.APIConfig) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		key, err := request.RequireString("issueIdOrKey")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		args := request.GetArguments()
+
+		var meta struct {
+			Fields map[string]metaField `json:"fields"`
+		}
+		if err := getJSON(ctx, cfg, "/api/2/issue/"+url.PathEscape(key)+"/editmeta", &meta); err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to get edit metadata", err), nil
+		}
+		resolver := newFieldResolver(ctx, cfg, meta.Fields, "edit screen of "+key)
+
+		update := map[string][]any{}
+		var problems []string
+		for _, verb := range updateVerbs {
+			input, _ := args[verb].(map[string]any)
+			for _, name := range sortedKeys(input) {
+				id, err := resolver.resolve(name)
+				if err != nil {
+					problems = append(problems, err.Error())
+					continue
+				}
+				f := meta.Fields[id]
+				if len(f.Operations) > 0 && !slices.Contains(f.Operations, verb) {
+					problems = append(problems, fmt.Sprintf("field %q does not support %s; supported: %s", f.Name, verb, strings.Join(f.Operations, ", ")))
+					continue
+				}
+				value, err := coerceField(f, input[name])
+				if err != nil {
+					problems = append(problems, fmt.Sprintf("field %q: %s", f.Name, err))
+					continue
+				}
+				if verb == "set" {
+					if s, ok := value.(string); ok && isRichText(f) {
+						value = toWiki(request, s)
+					}
+					update[id] = append(update[id], map[string]any{verb: value})
+					continue
+				}
+				// add and remove take one value per operation.
+				values, ok := value.([]any)
+				if !ok {
+					values = []any{value}
+				}
+				for _, v := range values {
+					update[id] = append(update[id], map[string]any{verb: v})
+				}
+			}
+		}
+		if len(problems) > 0 {
+			return mcp.NewToolResultError("Nothing was changed:\n- " + strings.Join(problems, "\n- ")), nil
+		}
+		if len(update) == 0 {
+			return mcp.NewToolResultError("Nothing to change: give fields in set, add or remove."), nil
+		}
+
+		ids := sortedKeys(update)
+		before, err := issueFields(ctx, cfg, key, ids)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to get the issue", err), nil
+		}
+		notify := request.GetBool("notifyUsers", true)
+		path := "/api/2/issue/" + url.PathEscape(key)
+		if !notify {
+			path += "?notifyUsers=false"
+		}
+		if _, err := jiraRequest(ctx, cfg, "PUT", path, map[string]any{"update": update}); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if client.IsDryRun(ctx) {
+			return mcp.NewToolResultText("{}"), nil // The dry-run result replaces this
+		}
+		after, err := issueFields(ctx, cfg, key, ids)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("The issue was updated but reading it back failed", err), nil
+		}
+
+		changes := []fieldChange{}
+		for _, id := range ids {
+			oldValue, newValue := displayValue(before[id]), displayValue(after[id])
+			if !reflect.DeepEqual(oldValue, newValue) {
+				changes = append(changes, fieldChange{Field: meta.Fields[id].Name, ID: id, Old: oldValue, New: newValue})
+			}
+		}
+		logging.FromContext(ctx).Info("issue updated", "issue", key, "fields", len(ids), "changed", len(changes))
+		out := map[string]any{"issue": key, "changes": changes, "notifyUsers": notify}
+		pretty, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+		}
+		return mcp.NewToolResultText(string(pretty)), nil
+	}
+}
+
+// issueFields returns the values of the given fields of an issue.
+func issueFields(ctx context.Context, cfg *config.APIConfig, key string, ids []string) (map[string]any, error) {
+	var issue struct {
+		Fields map[string]any `json:"fields"`
+	}
+	path := "/api/2/issue/" + url.PathEscape(key) + "?fields=" + url.QueryEscape(strings.Join(ids, ","))
+	if err := getJSON(ctx, cfg, path, &issue); err != nil {
+		return nil, err
+	}
+	return issue.Fields, nil
+}
+
+// displayValue reduces a field value to what a person would read: users,
+// options, versions and the like become their name, lists stay lists.
+func displayValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for _, key := range []string{"displayName", "name", "value", "key"} {
+			if s, ok := v[key].(string); ok {
+				if child, ok := v["child"].(map[string]any); ok {
+					return fmt.Sprintf("%s - %v", s, displayValue(child))
+				}
+				return s
+			}
+		}
+		return v
+	case []any:
+		out := make([]any, 0, len(v))
+		for _, item := range v {
+			out = append(out, displayValue(item))
+		}
+		return out
+	}
+	return v
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+	"github.com/jira-7-6-1/mcp-server/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// createUserTool gives jira_create_user the fields of the new user. The
+// generated tool sends no request body, so its handler is replaced rather
+// than wrapped.
+func createUserTool(tool models.Tool, cfg *config.APIConfig) models.Tool {
+	tool.Definition = mcp.NewTool(tool.Definition.Name,
+		mcp.WithDescription("Creates a user. The user is not notified by email unless notification is true. Without a password, Jira generates one. Full documentation: describe_jira_operation."),
+		mcp.WithString("name", mcp.Required(), mcp.Description("username, e.g. alice")),
+		mcp.WithString("emailAddress", mcp.Required(), mcp.Description("email address of the user")),
+		mcp.WithString("displayName", mcp.Required(), mcp.Description("full name of the user")),
+		mcp.WithString("password", mcp.Description("initial password, checked against the password policy in a dry run")),
+		mcp.WithBoolean("notification", mcp.Description("send the user an email about the new account")),
+		mcp.WithArray("applicationKeys", mcp.Description("applications the user gets access to, e.g. [\"jira-software\"]; the default applications when omitted"), mcp.WithStringItems()),
+	)
+	tool.Handler = createUserHandler(cfg)
+	return tool
+}
+
+func createUserHandler(cfg *config.APIConfig) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := map[string]any{}
+		for _, param := range []string{"name", "emailAddress", "displayName"} {
+			value, err := request.RequireString(param)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			user[param] = value
+		}
+		args := request.GetArguments()
+		for _, param := range []string{"password", "notification", "applicationKeys"} {
+			if value, ok := args[param]; ok {
+				user[param] = value
+			}
+		}
+
+		data, err := jiraRequest(ctx, cfg, "POST", "/api/2/user", user)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		var result map[string]any
+		if err := json.Unmarshal(data, &result); err != nil {
+			return mcp.NewToolResultText(string(data)), nil
+		}
+		logging.FromContext(ctx).Info("user created", "user", user["name"])
+		pretty, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("Failed to format JSON", err), nil
+		}
+		return mcp.NewToolResultText(string(pretty)), nil
+	}
+}
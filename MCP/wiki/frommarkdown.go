@@ -0,0 +1,260 @@
+package wiki
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	mdFence    = regexp.MustCompile("^\\s*(```|~~~)\\s*([\\w+#.-]*)\\s*$")
+	mdHeading  = regexp.MustCompile(`^(#{1,6})\s+(.*?)(?:\s+#+)?\s*$`)
+	mdRule     = regexp.MustCompile(`^\s*([-*_])(?:\s*([-*_])){2,}\s*$`)
+	mdList     = regexp.MustCompile(`^(\s*)([-*+]|[0-9]+[.)])\s+(.*)$`)
+	mdTableRow = regexp.MustCompile(`^\s*\|`)
+	mdTableSep = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+	mdAlert    = regexp.MustCompile(`^\[!(\w+)\](?:\s+(.*))?$`)
+	mdCode     = regexp.MustCompile("``\\s?(.+?)\\s?``|`([^`]+)`")
+	mdEscape   = regexp.MustCompile(`\\[\\` + "`" + `*_{}\[\]()#+\-.!|~>^?]`)
+	mdImage    = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	mdLink     = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	mdAutoLink = regexp.MustCompile(`<((?:https?|ftp)://[^>\s]+|mailto:[^>\s]+)>`)
+	mdBold     = regexp.MustCompile(`\*\*(\S(?:.*?\S)?)\*\*|__(\S(?:.*?\S)?)__`)
+	mdItalic   = regexp.MustCompile(`(^|[^\w*])\*([^\s*](?:[^*]*?[^\s*])?)\*($|[^\w*])`)
+	mdStrike   = regexp.MustCompile(`~~(\S(?:.*?\S)?)~~`)
+	mdBracket  = regexp.MustCompile(`\[[^\[\]]+\]`)
+
+	// wikiEffects match text that wiki markup would format, such as -strike-
+	// or +underline+, with the marker in $2.
+	wikiEffects = []*regexp.Regexp{effect(`-`, `-`), effect(`\+`, `+`), effect(`\^`, `^`), effect(`~`, `~`), effect(`\?\?`, `?`)}
+	// codeEffects also cover *bold* and _italic_, which Markdown code spans
+	// keep as they are.
+	codeEffects = append([]*regexp.Regexp{effect(`\*`, `*`), effect(`_`, `_`)}, wikiEffects...)
+)
+
+// effect matches a span between two markers the way the wiki renderer does:
+// the markers hug the text and stand apart from the words around them. c is
+// the marker character, as it goes in a character class.
+func effect(marker, c string) *regexp.Regexp {
+	inner := `[^\s\` + c + `]`
+	return regexp.MustCompile(`(^|[^\w\\\` + c + `])(` + marker + `)(` + inner + `|` + inner + `.*?` + inner + `)` + marker + `($|[^\w\` + c + `])`)
+}
+
+// FromMarkdown converts Markdown to Jira wiki markup.
+func FromMarkdown(text string) string {
+	in := lines(text)
+	var out []string
+	for i := 0; i < len(in); i++ {
+		line := strings.TrimRight(in[i], " \t")
+		if m := mdFence.FindStringSubmatch(line); m != nil {
+			var body []string
+			for i++; i < len(in) && !strings.HasPrefix(strings.TrimSpace(in[i]), m[1]); i++ {
+				body = append(body, in[i])
+			}
+			macro, open := "code", "{code}"
+			switch m[2] {
+			case "noformat":
+				macro, open = "noformat", "{noformat}"
+			case "":
+			default:
+				open = "{code:" + m[2] + "}"
+			}
+			out = append(out, slices.Concat([]string{open}, body, []string{"{" + macro + "}"})...)
+			continue
+		}
+		if mdTableRow.MatchString(line) && i+1 < len(in) && mdTableSep.MatchString(in[i+1]) {
+			rows := []string{line}
+			for i += 2; i < len(in) && mdTableRow.MatchString(in[i]); i++ {
+				rows = append(rows, in[i])
+			}
+			i--
+			out = append(out, tableToWiki(rows)...)
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), ">") {
+			var body []string
+			for ; i < len(in) && strings.HasPrefix(strings.TrimSpace(in[i]), ">"); i++ {
+				quoted := strings.TrimPrefix(strings.TrimSpace(in[i]), ">")
+				body = append(body, strings.TrimPrefix(quoted, " "))
+			}
+			i--
+			out = append(out, quoteToWiki(body)...)
+			continue
+		}
+		if mdList.MatchString(line) && !mdRule.MatchString(line) {
+			var items []string
+			for ; i < len(in) && mdList.MatchString(in[i]) && !mdRule.MatchString(in[i]); i++ {
+				items = append(items, in[i])
+			}
+			i--
+			out = append(out, listToWiki(items)...)
+			continue
+		}
+		switch {
+		case mdHeading.MatchString(line):
+			m := mdHeading.FindStringSubmatch(line)
+			out = append(out, "h"+string(rune('0'+len(m[1])))+". "+inlineToWiki(m[2]))
+		case mdRule.MatchString(line):
+			out = append(out, "----")
+		default:
+			out = append(out, inlineToWiki(line))
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// quoteToWiki turns a block quote into a panel when it starts with an alert
+// such as [!INFO] Title, into bq. when it is one line and into {quote}
+// otherwise.
+func quoteToWiki(body []string) []string {
+	if m := mdAlert.FindStringSubmatch(body[0]); m != nil && slices.Contains(panels, strings.ToLower(m[1])) {
+		name := strings.ToLower(m[1])
+		open := "{" + name + "}"
+		if m[2] != "" {
+			open = "{" + name + ":title=" + m[2] + "}"
+		}
+		return slices.Concat([]string{open}, lines(FromMarkdown(strings.Join(body[1:], "\n"))), []string{"{" + name + "}"})
+	}
+	if len(body) == 1 {
+		return []string{"bq. " + inlineToWiki(body[0])}
+	}
+	return slices.Concat([]string{"{quote}"}, lines(FromMarkdown(strings.Join(body, "\n"))), []string{"{quote}"})
+}
+
+// listToWiki nests items by indentation: an item indented further than the
+// one before is its child.
+func listToWiki(items []string) []string {
+	type level struct {
+		indent int
+		marker string
+	}
+	var stack []level
+	var out []string
+	for _, item := range items {
+		m := mdList.FindStringSubmatch(item)
+		indent := len(strings.ReplaceAll(m[1], "\t", "    "))
+		marker := "*"
+		if m[2][0] >= '0' && m[2][0] <= '9' {
+			marker = "#"
+		}
+		for len(stack) > 0 && stack[len(stack)-1].indent > indent {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) > 0 && stack[len(stack)-1].indent == indent {
+			stack[len(stack)-1].marker = marker
+		} else {
+			stack = append(stack, level{indent, marker})
+		}
+		var markers strings.Builder
+		for _, l := range stack {
+			markers.WriteString(l.marker)
+		}
+		out = append(out, markers.String()+" "+inlineToWiki(m[3]))
+	}
+	return out
+}
+
+// tableToWiki writes the header row with || unless all its cells are empty,
+// which is how a wiki table without a header comes out of ToMarkdown.
+func tableToWiki(rows []string) []string {
+	var out []string
+	for i, row := range rows {
+		row = strings.TrimSpace(row)
+		row = strings.TrimPrefix(row, "|")
+		if strings.HasSuffix(row, "|") && !strings.HasSuffix(row, `\|`) {
+			row = strings.TrimSuffix(row, "|")
+		}
+		cells := splitCells(row, "|")
+		empty := true
+		for j := range cells {
+			cells[j] = inlineToWiki(strings.TrimSpace(cells[j]))
+			empty = empty && cells[j] == ""
+		}
+		switch {
+		case i == 0 && empty:
+		case i == 0:
+			out = append(out, "||"+strings.Join(cells, "||")+"||")
+		default:
+			out = append(out, "|"+strings.Join(cells, "|")+"|")
+		}
+	}
+	return out
+}
+
+func inlineToWiki(text string) string {
+	var held placeholders
+	return held.restore(held.toWiki(text))
+}
+
+// toWiki converts text, holding the converted pieces in held. Link text is
+// converted with the same placeholders, since it may contain pieces held
+// before, such as code spans.
+func (held *placeholders) toWiki(text string) string {
+	text = mdCode.ReplaceAllStringFunc(text, func(m string) string {
+		sub := mdCode.FindStringSubmatch(m)
+		return held.hold("{{" + escapeWiki(sub[1]+sub[2], codeEffects, "{}[") + "}}")
+	})
+	text = mdEscape.ReplaceAllStringFunc(text, held.hold)
+	text = mdImage.ReplaceAllStringFunc(text, func(m string) string {
+		sub := mdImage.FindStringSubmatch(m)
+		if imageParams(sub[1]) {
+			return held.hold("!" + sub[2] + "|" + sub[1] + "!")
+		}
+		return held.hold("!" + sub[2] + "!")
+	})
+	text = mdLink.ReplaceAllStringFunc(text, func(m string) string {
+		sub := mdLink.FindStringSubmatch(m)
+		return held.hold("[" + held.toWiki(sub[1]) + "|" + sub[2] + "]")
+	})
+	text = mdAutoLink.ReplaceAllStringFunc(text, func(m string) string {
+		return held.hold("[" + mdAutoLink.FindStringSubmatch(m)[1] + "]")
+	})
+	// Mentions, issue keys and other wiki links, which ToMarkdown keeps
+	text = mdBracket.ReplaceAllStringFunc(text, held.hold)
+	text = escapeWiki(text, wikiEffects, "{")
+	text = mdBold.ReplaceAllStringFunc(text, func(m string) string {
+		sub := mdBold.FindStringSubmatch(m)
+		// Held so that the italic rule does not read *bold* as italic.
+		return held.hold("*") + sub[1] + sub[2] + held.hold("*")
+	})
+	text = replaceRepeatedly(mdItalic, text, "${1}_${2}_${3}")
+	return mdStrike.ReplaceAllString(text, "-$1-")
+}
+
+// escapeWiki backslash-escapes what wiki markup would read as formatting in
+// text: the characters in chars, which start macros or links, and the
+// markers of the spans that effects match.
+func escapeWiki(text string, effects []*regexp.Regexp, chars string) string {
+	for _, c := range chars {
+		text = strings.ReplaceAll(text, string(c), `\`+string(c))
+	}
+	for _, re := range effects {
+		for i := 0; i < 10; i++ {
+			next := re.ReplaceAllStringFunc(text, func(m string) string {
+				sub := re.FindStringSubmatch(m)
+				marker := escapeMarker(sub[2])
+				return sub[1] + marker + sub[3] + marker + sub[4]
+			})
+			if next == text {
+				break
+			}
+			text = next
+		}
+	}
+	return text
+}
+
+func escapeMarker(marker string) string {
+	var b strings.Builder
+	for _, c := range marker {
+		b.WriteString(`\` + string(c))
+	}
+	return b.String()
+}
+
+// imageParams reports whether the alt text of an image holds wiki image
+// parameters, as ToMarkdown writes them, rather than a description, which
+// Jira 7.6 has no place for.
+func imageParams(alt string) bool {
+	return alt == "thumbnail" || strings.Contains(alt, "=")
+}
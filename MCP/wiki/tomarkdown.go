@@ -0,0 +1,228 @@
+package wiki
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	wikiHeading  = regexp.MustCompile(`^h([1-6])\.\s+(.*)$`)
+	wikiList     = regexp.MustCompile(`^([*#]+|-)\s+(.*)$`)
+	wikiRule     = regexp.MustCompile(`^-{4,}\s*$`)
+	wikiBlock    = regexp.MustCompile(`^\{(code|noformat|quote|panel|info|note|warning|tip)(?::([^}]*))?\}(.*)$`)
+	wikiMono     = regexp.MustCompile(`\{\{(.+?)\}\}`)
+	wikiEscape   = regexp.MustCompile(`\\.`)
+	wikiUnescape = regexp.MustCompile(`\\(.)`)
+	wikiImage    = regexp.MustCompile(`!([^!\s|]+?)(?:\|([^!]*))?!`)
+	wikiLink     = regexp.MustCompile(`\[([^\[\]|]*)\|([^\[\]]+)\]`)
+	wikiBareLink = regexp.MustCompile(`\[([^\[\]|]+)\]`)
+	wikiBold     = regexp.MustCompile(`(^|[^\w*])\*([^\s*](?:[^*]*?[^\s*])?)\*($|[^\w*])`)
+	wikiStrike   = regexp.MustCompile(`(^|[\s(])-([^\s-](?:[^-]*?[^\s-])?)-($|[\s.,;:!?)])`)
+)
+
+// ToMarkdown converts Jira wiki markup to Markdown.
+func ToMarkdown(text string) string {
+	in := lines(text)
+	var out []string
+	for i := 0; i < len(in); i++ {
+		line := strings.TrimRight(in[i], " \t")
+		if m := wikiBlock.FindStringSubmatch(line); m != nil {
+			var body []string
+			body, i = blockBody(in, i, m[1], m[3])
+			out = append(out, blockToMarkdown(m[1], m[2], body)...)
+			continue
+		}
+		if strings.HasPrefix(line, "|") {
+			var rows []string
+			for ; i < len(in) && strings.HasPrefix(strings.TrimSpace(in[i]), "|"); i++ {
+				rows = append(rows, strings.TrimSpace(in[i]))
+			}
+			i--
+			out = append(out, tableToMarkdown(rows)...)
+			continue
+		}
+		if wikiList.MatchString(line) && !wikiRule.MatchString(line) {
+			var items []string
+			for ; i < len(in) && wikiList.MatchString(in[i]) && !wikiRule.MatchString(in[i]); i++ {
+				items = append(items, in[i])
+			}
+			i--
+			out = append(out, listToMarkdown(items)...)
+			continue
+		}
+		switch {
+		case wikiHeading.MatchString(line):
+			m := wikiHeading.FindStringSubmatch(line)
+			out = append(out, strings.Repeat("#", int(m[1][0]-'0'))+" "+inlineToMarkdown(m[2]))
+		case wikiRule.MatchString(line):
+			out = append(out, "---")
+		case strings.HasPrefix(line, "bq. "):
+			out = append(out, "> "+inlineToMarkdown(strings.TrimPrefix(line, "bq. ")))
+		default:
+			out = append(out, inlineToMarkdown(line))
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// blockBody collects the lines of a {name} block that starts on line i with
+// rest after the opening macro, and returns them with the index of the line
+// that closes it.
+func blockBody(in []string, i int, name, rest string) ([]string, int) {
+	closing := "{" + name + "}"
+	if before, _, found := strings.Cut(rest, closing); found {
+		return []string{before}, i
+	}
+	var body []string
+	if rest != "" {
+		body = append(body, rest)
+	}
+	for i++; i < len(in); i++ {
+		if before, _, found := strings.Cut(in[i], closing); found {
+			if before != "" {
+				body = append(body, before)
+			}
+			return body, i
+		}
+		body = append(body, in[i])
+	}
+	return body, i
+}
+
+func blockToMarkdown(name, params string, body []string) []string {
+	switch name {
+	case "code", "noformat":
+		lang := ""
+		for _, p := range strings.Split(params, "|") {
+			if p != "" && !strings.Contains(p, "=") {
+				lang = p
+			} else if v, ok := strings.CutPrefix(p, "language="); ok {
+				lang = v
+			}
+		}
+		if name == "noformat" {
+			lang = "noformat"
+		}
+		return slices.Concat([]string{"```" + lang}, body, []string{"```"})
+	case "quote":
+		return quote(lines(ToMarkdown(strings.Join(body, "\n"))))
+	}
+	head := "[!" + strings.ToUpper(name) + "]"
+	for _, p := range strings.Split(params, "|") {
+		if title, ok := strings.CutPrefix(p, "title="); ok {
+			head += " " + title
+		}
+	}
+	return quote(append([]string{head}, lines(ToMarkdown(strings.Join(body, "\n")))...))
+}
+
+func quote(in []string) []string {
+	out := make([]string, 0, len(in))
+	for _, line := range in {
+		if line == "" {
+			out = append(out, ">")
+		} else {
+			out = append(out, "> "+line)
+		}
+	}
+	return out
+}
+
+// listToMarkdown indents each item under its parent by the width of the
+// parent's marker.
+func listToMarkdown(items []string) []string {
+	var out []string
+	for _, item := range items {
+		m := wikiList.FindStringSubmatch(item)
+		markers := strings.ReplaceAll(m[1], "-", "*")
+		indent := ""
+		for _, parent := range markers[:len(markers)-1] {
+			indent += strings.Repeat(" ", len(markdownMarker(parent)))
+		}
+		out = append(out, indent+markdownMarker(rune(markers[len(markers)-1]))+inlineToMarkdown(m[2]))
+	}
+	return out
+}
+
+func markdownMarker(wiki rune) string {
+	if wiki == '#' {
+		return "1. "
+	}
+	return "- "
+}
+
+func tableToMarkdown(rows []string) []string {
+	var cells [][]string
+	width := 0
+	header := strings.HasPrefix(rows[0], "||")
+	for _, row := range rows {
+		sep := "|"
+		if strings.HasPrefix(row, "||") {
+			sep = "||"
+		}
+		row = strings.TrimPrefix(strings.TrimSuffix(row, sep), sep)
+		var converted []string
+		for _, cell := range splitCells(row, sep) {
+			converted = append(converted, inlineToMarkdown(strings.TrimSpace(cell)))
+		}
+		cells = append(cells, converted)
+		width = max(width, len(converted))
+	}
+	if !header {
+		cells = append([][]string{make([]string, width)}, cells...)
+	}
+	line := func(row []string) string {
+		row = append(row, make([]string, width-len(row))...)
+		return "| " + strings.Join(row, " | ") + " |"
+	}
+	rule := make([]string, width)
+	for i := range rule {
+		rule[i] = "---"
+	}
+	out := []string{line(cells[0]), line(rule)}
+	for _, row := range cells[1:] {
+		out = append(out, line(row))
+	}
+	return out
+}
+
+func inlineToMarkdown(text string) string {
+	var held placeholders
+	return held.restore(held.toMarkdown(text))
+}
+
+// toMarkdown converts text, holding the converted pieces in held. Link text
+// is converted with the same placeholders, since it may contain pieces held
+// before, such as monospace.
+func (held *placeholders) toMarkdown(text string) string {
+	text = wikiEscape.ReplaceAllStringFunc(text, held.hold)
+	text = wikiMono.ReplaceAllStringFunc(text, func(m string) string {
+		// Escapes inside monospace keep markup literal; code spans need none
+		code := wikiUnescape.ReplaceAllString(held.restore(wikiMono.FindStringSubmatch(m)[1]), "$1")
+		if strings.Contains(code, "`") {
+			return held.hold("`` " + code + " ``")
+		}
+		return held.hold("`" + code + "`")
+	})
+	text = wikiImage.ReplaceAllStringFunc(text, func(m string) string {
+		sub := wikiImage.FindStringSubmatch(m)
+		return held.hold("![" + sub[2] + "](" + sub[1] + ")")
+	})
+	text = wikiLink.ReplaceAllStringFunc(text, func(m string) string {
+		sub := wikiLink.FindStringSubmatch(m)
+		if !isURL(sub[2]) {
+			return held.hold(m)
+		}
+		return held.hold("[" + held.toMarkdown(sub[1]) + "](" + sub[2] + ")")
+	})
+	text = wikiBareLink.ReplaceAllStringFunc(text, func(m string) string {
+		target := wikiBareLink.FindStringSubmatch(m)[1]
+		if isURL(target) {
+			return held.hold("<" + target + ">")
+		}
+		return held.hold(m) // Mentions, issue keys, anchors and attachments
+	})
+	text = replaceRepeatedly(wikiBold, text, "$1**$2**$3")
+	return replaceRepeatedly(wikiStrike, text, "$1~~$2~~$3")
+}
@@ -0,0 +1,115 @@
+// Package wiki converts between Jira 7.6 wiki markup and Markdown.
+//
+// The conversion covers what descriptions and comments commonly use:
+// headings, emphasis, monospace, links, images, mentions, lists, tables,
+// quotes, code blocks and panels. Both directions map one canonical form to
+// the other, so Markdown produced by ToMarkdown converts back to the same
+// wiki markup:
+//
+//	h2. Title              ## Title
+//	*bold* _italic_        **bold** _italic_
+//	-deleted- {{code}}     ~~deleted~~ `code`
+//	[text|http://x]        [text](http://x)
+//	[~alice]               [~alice]
+//	!image.png|thumbnail!  ![thumbnail](image.png)
+//	* item / ** nested     - item / "  - nested"
+//	# first                1. first
+//	||a||b|| / |1|2|       | a | b | / | --- | --- | / | 1 | 2 |
+//	bq. quote / {quote}    > quote
+//	{code:go} / {noformat} ```go / ```noformat
+//	{info:title=Note}      > [!INFO] Note
+//
+// FromMarkdown backslash-escapes text that wiki markup would otherwise
+// format, such as a literal {, -dashes- or +plus+, in text and code spans.
+// Markup without a Markdown equivalent, such as {color} or +underline+, is
+// left as it is by ToMarkdown, so it reads as literal text once converted
+// back; it is only written with wiki markup.
+package wiki
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// panels are the wiki macros shown as Markdown alerts, e.g. > [!INFO].
+var panels = []string{"panel", "info", "note", "warning", "tip"}
+
+// placeholders hold pieces of a line that inline rules must not touch,
+// such as code spans and links, while the other rules run.
+type placeholders []string
+
+func (p *placeholders) hold(s string) string {
+	*p = append(*p, s)
+	return fmt.Sprintf("\x00%d\x00", len(*p)-1)
+}
+
+var heldPiece = regexp.MustCompile("\x00([0-9]+)\x00")
+
+// restore puts the held pieces back, including pieces held inside others.
+// A piece can only contain pieces held before it, so each level of nesting
+// looks at fewer pieces.
+func (p placeholders) restore(s string) string {
+	return heldPiece.ReplaceAllStringFunc(s, func(m string) string {
+		i, err := strconv.Atoi(strings.Trim(m, "\x00"))
+		if err != nil || i >= len(p) {
+			return ""
+		}
+		return p[:i].restore(p[i])
+	})
+}
+
+// replaceRepeatedly applies a replacement until nothing changes. Emphasis
+// patterns consume the character before and after the marker, so adjacent
+// spans such as "*a* *b*" need more than one pass.
+func replaceRepeatedly(re *regexp.Regexp, s, repl string) string {
+	for i := 0; i < 10; i++ {
+		next := re.ReplaceAllString(s, repl)
+		if next == s {
+			break
+		}
+		s = next
+	}
+	return s
+}
+
+// isURL reports whether a link target is a web or mail address rather than
+// an issue key, anchor or attachment.
+func isURL(target string) bool {
+	return strings.Contains(target, "://") || strings.HasPrefix(target, "mailto:")
+}
+
+// splitCells splits a table row on sep, ignoring separators inside links,
+// macros, code spans and after a backslash.
+func splitCells(row, sep string) []string {
+	var cells []string
+	depth, start := 0, 0
+	inCode := false
+	for i := 0; i < len(row); i++ {
+		switch c := row[i]; {
+		case c == '\\':
+			i++
+		case c == '`':
+			inCode = !inCode
+		case inCode:
+		case c == '[' || c == '{':
+			depth++
+		case (c == ']' || c == '}') && depth > 0:
+			depth--
+		case depth == 0 && strings.HasPrefix(row[i:], sep):
+			cells = append(cells, row[start:i])
+			i += len(sep) - 1
+			start = i + 1
+		}
+	}
+	return append(cells, row[start:])
+}
+
+// lines splits text into lines. NUL characters are dropped, as placeholders
+// are written with them.
+func lines(text string) []string {
+	text = strings.ReplaceAll(text, "\x00", "")
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.Split(strings.TrimRight(text, "\n"), "\n")
+}
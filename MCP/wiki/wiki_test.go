@@ -0,0 +1,96 @@
+package wiki
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the .wiki golden files from the .md files")
+
+// TestGolden converts each testdata/*.md file to wiki markup and its .wiki
+// file to Markdown. Each pair is the canonical form of the other, so both
+// directions must reproduce the other file exactly, which makes every
+// construct in them survive a round trip.
+func TestGolden(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.md")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no golden files: %v", err)
+	}
+	for _, mdFile := range files {
+		name := strings.TrimSuffix(filepath.Base(mdFile), ".md")
+		wikiFile := strings.TrimSuffix(mdFile, ".md") + ".wiki"
+		t.Run(name, func(t *testing.T) {
+			md := read(t, mdFile)
+			if *update {
+				if err := os.WriteFile(wikiFile, []byte(FromMarkdown(md)+"\n"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			wiki := read(t, wikiFile)
+			if got := FromMarkdown(md); got != wiki {
+				t.Errorf("FromMarkdown(%s):\n%s\nwant:\n%s", mdFile, got, wiki)
+			}
+			if got := ToMarkdown(wiki); got != md {
+				t.Errorf("ToMarkdown(%s):\n%s\nwant:\n%s", wikiFile, got, md)
+			}
+		})
+	}
+}
+
+// TestNormalize covers spellings that convert to the canonical form of the
+// other side but do not come back the same way.
+func TestNormalize(t *testing.T) {
+	fromMarkdown := []struct{ in, want string }{
+		{"*italic* and __bold__", "_italic_ and *bold*"},
+		{"* star\n+ plus\n2. two", "* star\n* plus\n# two"},
+		{"## Title ##", "h2. Title"},
+		{"***", "----"},
+		{"![a screenshot](shot.png)", "!shot.png!"},
+		{"[https://x.example](https://x.example)", "[https://x.example|https://x.example]"},
+		{"~~~\ncode\n~~~", "{code}\ncode\n{code}"},
+		{"| a | b |\n|:--|--:|\n| 1 | 2 |", "||a||b||\n|1|2|"},
+		{"| a \\| b |\n| --- |\n| c |", "||a \\| b||\n|c|"},
+		{"`{code}` inline", "{{\\{code\\}}} inline"},
+		{"`*ptr*` and `[x]`", "{{\\*ptr\\*}} and {{\\[x]}}"},
+		{"a -strike- and +plus+", "a \\-strike\\- and \\+plus\\+"},
+		{"^sup^, ~sub~ and ??cite??", "\\^sup\\^, \\~sub\\~ and \\?\\?cite\\?\\?"},
+		{"{color:red}red{color}", "\\{color:red}red\\{color}"},
+		{"[~alice] and [~bob], ~~gone~~", "[~alice] and [~bob], -gone-"},
+		{"re-index 2017-11-01 - done", "re-index 2017-11-01 - done"},
+		{"a\x00b", "ab"},
+		{"\x005\x00 and `x`", "5 and {{x}}"},
+	}
+	for _, c := range fromMarkdown {
+		if got := FromMarkdown(c.in); got != c.want {
+			t.Errorf("FromMarkdown(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+	toMarkdown := []struct{ in, want string }{
+		{"- dash item", "- dash item"},
+		{"{code:title=Main.java|language=java}\nx();\n{code}", "```java\nx();\n```"},
+		{"{code}one line{code}", "```\none line\n```"},
+		{"{panel}\nplain\n{panel}", "> [!PANEL]\n> plain"},
+		{"|a|b|\n|c|", "|  |  |\n| --- | --- |\n| a | b |\n| c |  |"},
+		{"line\\\\break", "line\\\\break"},
+		{"{color:red}red{color} and +underline+", "{color:red}red{color} and +underline+"},
+		{"a\x00b", "ab"},
+		{"\x005\x00 and {{x}}", "5 and `x`"},
+	}
+	for _, c := range toMarkdown {
+		if got := ToMarkdown(c.in); got != c.want {
+			t.Errorf("ToMarkdown(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func read(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.TrimSuffix(string(data), "\n")
+}
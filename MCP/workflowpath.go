@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/jira-7-6-1/mcp-server/client"
+	"github.com/jira-7-6-1/mcp-server/config"
+	"github.com/jira-7-6-1/mcp-server/logging"
+)
+
+// workflowHistorySize is how many recently moved issues of the same project
+// and issue type are read to learn the workflow.
+const workflowHistorySize = 50
+
+// workflowGraph maps a status, in lower case, to the statuses it has a
+// transition to. The Jira 7.6 REST API does not expose the transitions of a
+// workflow, so the graph is learned from the status changes of similar
+// issues and the transitions currently available to the issue.
+type workflowGraph map[string]map[string]string
+
+func (g workflowGraph) add(from, to string) {
+	key := strings.ToLower(from)
+	if g[key] == nil {
+		g[key] = map[string]string{}
+	}
+	g[key][strings.ToLower(to)] = to
+}
+
+// shortestPath returns the statuses after from on the shortest path to to,
+// or nil when the graph has none.
+func (g workflowGraph) shortestPath(from, to string) []string {
+	start, goal := strings.ToLower(from), strings.ToLower(to)
+	previous := map[string]string{start: ""}
+	queue := []string{start}
+	for len(queue) > 0 {
+		status := queue[0]
+		queue = queue[1:]
+		if status == goal {
+			var path []string
+			for s := status; s != start; s = previous[s] {
+				path = append([]string{g[previous[s]][s]}, path...)
+			}
+			return path
+		}
+		for _, next := range sortedKeys(g[status]) {
+			if _, seen := previous[next]; !seen {
+				previous[next] = status
+				queue = append(queue, next)
+			}
+		}
+	}
+	return nil
+}
+
+// learnWorkflow builds the workflow graph of an issue from its available
+// transitions and the status changes of issues of the same project and
+// issue type.
+func learnWorkflow(ctx context.Context, cfg *config.APIConfig, current string, transitions []issueTransition, project, issueType string) workflowGraph {
+	g := workflowGraph{}
+	for _, t := range transitions {
+		g.add(current, t.To.Name)
+	}
+	if project == "" || issueType == "" {
+		return g
+	}
+	jql := fmt.Sprintf("project = %s AND issuetype = %s AND status CHANGED ORDER BY updated DESC", jqlString(project), jqlString(issueType))
+	query := url.Values{
+		"jql":        {jql},
+		"fields":     {"status"},
+		"expand":     {"changelog"},
+		"maxResults": {fmt.Sprint(workflowHistorySize)},
+	}
+	var result struct {
+		Issues []struct {
+			Changelog struct {
+				Histories []struct {
+					Items []struct {
+						Field      string `json:"field"`
+						FromString string `json:"fromString"`
+						ToString   string `json:"toString"`
+					} `json:"items"`
+				} `json:"histories"`
+			} `json:"changelog"`
+		} `json:"issues"`
+	}
+	if err := getJSON(ctx, cfg, "/api/2/search?"+query.Encode(), &result); err != nil {
+		logging.FromContext(ctx).Warn("workflow history unavailable", "error", err)
+		return g
+	}
+	for _, issue := range result.Issues {
+		for _, h := range issue.Changelog.Histories {
+			for _, item := range h.Items {
+				if item.Field == "status" && item.FromString != "" && item.ToString != "" {
+					g.add(item.FromString, item.ToString)
+				}
+			}
+		}
+	}
+	return g
+}
+
+// hop is one transition of a multi-hop move and what became of it.
+type hop struct {
+	From       string `json:"from"`
+	Transition string `json:"transition,omitempty"`
+	To         string `json:"to"`
+	Result     string `json:"result"` // done, failed, planned or not run
+	Error      string `json:"error,omitempty"`
+}
+
+// walkReport is the outcome of walking a path of statuses.
+type walkReport struct {
+	Issue        string   `json:"issue"`
+	From         string   `json:"from"`
+	Target       string   `json:"target"`
+	Status       string   `json:"status"` // Where the issue is now
+	Hops         []hop    `json:"hops"`
+	UnusedFields []string `json:"unusedFields,omitempty"`
+	Rollback     string   `json:"rollback,omitempty"`
+}
+
+// walkPath moves an issue along path, one transition at a time. Each hop
+// takes the fields of input that are on its screen; the comment goes with
+// the last hop. It stops at the first hop that is unavailable, lacks a
+// required field or fails, and reports how far it got. A dry run records
+// the first hop and plans the rest, whose screens are unknown until the
+// issue gets there.
+func walkPath(ctx context.Context, cfg *config.APIConfig, key, current string, path []string, input map[string]any, comment string) (walkReport, bool) {
+	report := walkReport{Issue: key, From: current, Target: path[len(path)-1], Status: current}
+	used := map[string]bool{}
+	failed := false
+	from := current
+	for i, next := range path {
+		h := hop{From: from, To: next, Result: "not run"}
+		from = next
+		switch {
+		case failed:
+		case client.IsDryRun(ctx) && i > 0:
+			h.Result = "planned"
+		default:
+			if err := walkHop(ctx, cfg, key, &h, input, used, i == len(path)-1, comment); err != nil {
+				h.Result, h.Error = "failed", err.Error()
+				failed = true
+			} else if client.IsDryRun(ctx) {
+				h.Result = "planned"
+			} else {
+				h.Result = "done"
+				report.Status = next
+			}
+		}
+		report.Hops = append(report.Hops, h)
+	}
+	for _, name := range sortedKeys(input) {
+		if !used[name] && !failed && !client.IsDryRun(ctx) {
+			report.UnusedFields = append(report.UnusedFields, name)
+		}
+	}
+	if failed && report.Status != report.From {
+		report.Rollback = fmt.Sprintf("%s was moved from %s to %s. To undo, call jira_transition_issue with status %q, and maxHops if it is more than one transition away.", key, report.From, report.Status, report.From)
+	}
+	logging.FromContext(ctx).Info("issue moved along workflow path", "issue", key, "from", report.From, "status", report.Status, "target", report.Target, "failed", failed)
+	return report, !failed
+}
+
+// walkHop performs the hop h, recording the transition it used.
+func walkHop(ctx context.Context, cfg *config.APIConfig, key string, h *hop, input map[string]any, used map[string]bool, last bool, comment string) error {
+	transitions, err := issueTransitions(ctx, cfg, key)
+	if err != nil {
+		return err
+	}
+	t, ok := directTransition(transitions, h.To)
+	if !ok {
+		return fmt.Errorf("no transition from %s to %s is available", h.From, h.To)
+	}
+	h.Transition = t.Name
+
+	resolver := newFieldResolver(ctx, cfg, t.Fields, fmt.Sprintf("screen of transition %s", t.Name))
+	onScreen := map[string]any{}
+	for name, value := range input {
+		if resolver.onScreen(name) {
+			onScreen[name] = value
+			used[name] = true
+		}
+	}
+	fields, problems := resolver.resolveAll(onScreen)
+	problems = append(problems, resolver.missingRequired(fields)...)
+	if len(problems) > 0 {
+		return fmt.Errorf("%s needs: %s", t, strings.Join(problems, "; "))
+	}
+	if !last {
+		comment = ""
+	}
+	return doTransition(ctx, cfg, key, t, fields, comment)
+}
+
+// directTransition returns the first available transition leading to status.
+func directTransition(transitions []issueTransition, status string) (issueTransition, bool) {
+	for _, t := range transitions {
+		if strings.EqualFold(t.To.Name, status) {
+			return t, true
+		}
+	}
+	return issueTransition{}, false
+}
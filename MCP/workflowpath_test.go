@@ -0,0 +1,41 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestShortestPath(t *testing.T) {
+	g := workflowGraph{}
+	for _, edge := range [][2]string{
+		{"Open", "In Progress"},
+		{"Open", "Closed"},
+		{"In Progress", "In Review"},
+		{"In Progress", "Open"},
+		{"In Review", "Done"},
+		{"In Review", "In Progress"},
+		{"Done", "Reopened"},
+		{"Reopened", "In Progress"},
+		{"Backlog", "Open"},
+	} {
+		g.add(edge[0], edge[1])
+	}
+	tests := []struct {
+		from, to string
+		want     []string
+	}{
+		{"Open", "In Progress", []string{"In Progress"}},
+		{"Open", "Done", []string{"In Progress", "In Review", "Done"}},
+		{"open", "DONE", []string{"In Progress", "In Review", "Done"}},
+		{"Done", "In Review", []string{"Reopened", "In Progress", "In Review"}},
+		{"Reopened", "Closed", []string{"In Progress", "Open", "Closed"}},
+		{"Closed", "Open", nil},
+		{"Open", "Backlog", nil},
+		{"Open", "Unknown", nil},
+	}
+	for _, tt := range tests {
+		if got := g.shortestPath(tt.from, tt.to); !slices.Equal(got, tt.want) {
+			t.Errorf("shortestPath(%q, %q) = %q, want %q", tt.from, tt.to, got, tt.want)
+		}
+	}
+}